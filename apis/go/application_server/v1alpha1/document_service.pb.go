@@ -59,6 +59,11 @@ const (
 	// Existence operators
 	MetadataOperator_METADATA_OPERATOR_EXISTS     MetadataOperator = 12
 	MetadataOperator_METADATA_OPERATOR_NOT_EXISTS MetadataOperator = 13
+	// Pattern operators
+	MetadataOperator_METADATA_OPERATOR_REGEX_MATCH MetadataOperator = 14
+	// Date operators (RFC3339 or YYYY-MM-DD values, compared chronologically)
+	MetadataOperator_METADATA_OPERATOR_DATE_BEFORE MetadataOperator = 15
+	MetadataOperator_METADATA_OPERATOR_DATE_AFTER  MetadataOperator = 16
 )
 
 // Enum value maps for MetadataOperator.
@@ -78,6 +83,9 @@ var (
 		11: "METADATA_OPERATOR_NOT_IN",
 		12: "METADATA_OPERATOR_EXISTS",
 		13: "METADATA_OPERATOR_NOT_EXISTS",
+		14: "METADATA_OPERATOR_REGEX_MATCH",
+		15: "METADATA_OPERATOR_DATE_BEFORE",
+		16: "METADATA_OPERATOR_DATE_AFTER",
 	}
 	MetadataOperator_value = map[string]int32{
 		"METADATA_OPERATOR_UNSPECIFIED":           0,
@@ -94,6 +102,9 @@ var (
 		"METADATA_OPERATOR_NOT_IN":                11,
 		"METADATA_OPERATOR_EXISTS":                12,
 		"METADATA_OPERATOR_NOT_EXISTS":            13,
+		"METADATA_OPERATOR_REGEX_MATCH":           14,
+		"METADATA_OPERATOR_DATE_BEFORE":           15,
+		"METADATA_OPERATOR_DATE_AFTER":            16,
 	}
 )
 
@@ -216,6 +227,108 @@ func (x *ListDocumentsResponse) GetDocuments() []*Document {
 	return nil
 }
 
+// ExecuteCommandRequest defines the request for executing a document command
+type ExecuteCommandRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Command is the command identifier (e.g., "notedown.archiveCompletedTasks")
+	Command string `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	// Path is the relative path from workspace root of the document to operate on. It must be a
+	// note returned by ListDocuments, symlinks are followed but must stay inside the workspace
+	Path          string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteCommandRequest) Reset() {
+	*x = ExecuteCommandRequest{}
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteCommandRequest) ProtoMessage() {}
+
+func (x *ExecuteCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteCommandRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteCommandRequest) Descriptor() ([]byte, []int) {
+	return file_application_server_v1alpha1_document_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExecuteCommandRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ExecuteCommandRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// ExecuteCommandResponse contains the result of executing a document command
+type ExecuteCommandResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ChangedPaths are the relative paths of documents that were rewritten
+	ChangedPaths  []string `protobuf:"bytes,1,rep,name=changed_paths,json=changedPaths,proto3" json:"changed_paths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteCommandResponse) Reset() {
+	*x = ExecuteCommandResponse{}
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteCommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteCommandResponse) ProtoMessage() {}
+
+func (x *ExecuteCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteCommandResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteCommandResponse) Descriptor() ([]byte, []int) {
+	return file_application_server_v1alpha1_document_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExecuteCommandResponse) GetChangedPaths() []string {
+	if x != nil {
+		return x.ChangedPaths
+	}
+	return nil
+}
+
 // Document represents a Notedown Flavored Markdown document
 type Document struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -235,7 +348,7 @@ type Document struct {
 
 func (x *Document) Reset() {
 	*x = Document{}
-	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[2]
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -247,7 +360,7 @@ func (x *Document) String() string {
 func (*Document) ProtoMessage() {}
 
 func (x *Document) ProtoReflect() protoreflect.Message {
-	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[2]
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -260,7 +373,7 @@ func (x *Document) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Document.ProtoReflect.Descriptor instead.
 func (*Document) Descriptor() ([]byte, []int) {
-	return file_application_server_v1alpha1_document_service_proto_rawDescGZIP(), []int{2}
+	return file_application_server_v1alpha1_document_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Document) GetPath() string {
@@ -314,7 +427,7 @@ type FilterExpression struct {
 
 func (x *FilterExpression) Reset() {
 	*x = FilterExpression{}
-	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[3]
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -326,7 +439,7 @@ func (x *FilterExpression) String() string {
 func (*FilterExpression) ProtoMessage() {}
 
 func (x *FilterExpression) ProtoReflect() protoreflect.Message {
-	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[3]
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -339,7 +452,7 @@ func (x *FilterExpression) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterExpression.ProtoReflect.Descriptor instead.
 func (*FilterExpression) Descriptor() ([]byte, []int) {
-	return file_application_server_v1alpha1_document_service_proto_rawDescGZIP(), []int{3}
+	return file_application_server_v1alpha1_document_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *FilterExpression) GetExpression() isFilterExpression_Expression {
@@ -420,7 +533,8 @@ func (*FilterExpression_NotFilter) isFilterExpression_Expression() {}
 // MetadataFilter filters documents based on frontmatter metadata
 type MetadataFilter struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Field is the metadata field name to filter on
+	// Field is the metadata field name to filter on, nested fields and array
+	// elements can be addressed with dot paths such as "project.status" or "authors.0"
 	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
 	// Operator defines how to compare the field value
 	Operator MetadataOperator `protobuf:"varint,2,opt,name=operator,proto3,enum=notedown.application_server.v1alpha1.MetadataOperator" json:"operator,omitempty"`
@@ -432,7 +546,7 @@ type MetadataFilter struct {
 
 func (x *MetadataFilter) Reset() {
 	*x = MetadataFilter{}
-	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[4]
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -444,7 +558,7 @@ func (x *MetadataFilter) String() string {
 func (*MetadataFilter) ProtoMessage() {}
 
 func (x *MetadataFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[4]
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -457,7 +571,7 @@ func (x *MetadataFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetadataFilter.ProtoReflect.Descriptor instead.
 func (*MetadataFilter) Descriptor() ([]byte, []int) {
-	return file_application_server_v1alpha1_document_service_proto_rawDescGZIP(), []int{4}
+	return file_application_server_v1alpha1_document_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *MetadataFilter) GetField() string {
@@ -491,7 +605,7 @@ type AndFilter struct {
 
 func (x *AndFilter) Reset() {
 	*x = AndFilter{}
-	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[5]
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -503,7 +617,7 @@ func (x *AndFilter) String() string {
 func (*AndFilter) ProtoMessage() {}
 
 func (x *AndFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[5]
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -516,7 +630,7 @@ func (x *AndFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AndFilter.ProtoReflect.Descriptor instead.
 func (*AndFilter) Descriptor() ([]byte, []int) {
-	return file_application_server_v1alpha1_document_service_proto_rawDescGZIP(), []int{5}
+	return file_application_server_v1alpha1_document_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AndFilter) GetFilters() []*FilterExpression {
@@ -536,7 +650,7 @@ type OrFilter struct {
 
 func (x *OrFilter) Reset() {
 	*x = OrFilter{}
-	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[6]
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -548,7 +662,7 @@ func (x *OrFilter) String() string {
 func (*OrFilter) ProtoMessage() {}
 
 func (x *OrFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[6]
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -561,7 +675,7 @@ func (x *OrFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrFilter.ProtoReflect.Descriptor instead.
 func (*OrFilter) Descriptor() ([]byte, []int) {
-	return file_application_server_v1alpha1_document_service_proto_rawDescGZIP(), []int{6}
+	return file_application_server_v1alpha1_document_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *OrFilter) GetFilters() []*FilterExpression {
@@ -581,7 +695,7 @@ type NotFilter struct {
 
 func (x *NotFilter) Reset() {
 	*x = NotFilter{}
-	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[7]
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -593,7 +707,7 @@ func (x *NotFilter) String() string {
 func (*NotFilter) ProtoMessage() {}
 
 func (x *NotFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[7]
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,7 +720,7 @@ func (x *NotFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotFilter.ProtoReflect.Descriptor instead.
 func (*NotFilter) Descriptor() ([]byte, []int) {
-	return file_application_server_v1alpha1_document_service_proto_rawDescGZIP(), []int{7}
+	return file_application_server_v1alpha1_document_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *NotFilter) GetFilter() *FilterExpression {
@@ -619,21 +733,24 @@ func (x *NotFilter) GetFilter() *FilterExpression {
 // Wikilink represents a wikilink found in a document
 type Wikilink struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Target is the wikilink target (e.g., "project-alpha", "docs/api")
+	// Target is the note the wikilink points to (e.g., "project-alpha", "docs/api"). It is empty for
+	// links to a heading of the current document such as [[#Summary]], in which case anchor is set
 	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	// DisplayText is the display text if pipe notation is used
 	DisplayText string `protobuf:"bytes,2,opt,name=display_text,json=displayText,proto3" json:"display_text,omitempty"`
 	// Line is the 1-based line number where the wikilink appears
 	Line int32 `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
 	// Column is the 1-based column number where the wikilink appears
-	Column        int32 `protobuf:"varint,4,opt,name=column,proto3" json:"column,omitempty"`
+	Column int32 `protobuf:"varint,4,opt,name=column,proto3" json:"column,omitempty"`
+	// Anchor is the heading anchor if the wikilink targets a section (e.g., "Goals" in [[design#Goals]])
+	Anchor        string `protobuf:"bytes,5,opt,name=anchor,proto3" json:"anchor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Wikilink) Reset() {
 	*x = Wikilink{}
-	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[8]
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -645,7 +762,7 @@ func (x *Wikilink) String() string {
 func (*Wikilink) ProtoMessage() {}
 
 func (x *Wikilink) ProtoReflect() protoreflect.Message {
-	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[8]
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,7 +775,7 @@ func (x *Wikilink) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Wikilink.ProtoReflect.Descriptor instead.
 func (*Wikilink) Descriptor() ([]byte, []int) {
-	return file_application_server_v1alpha1_document_service_proto_rawDescGZIP(), []int{8}
+	return file_application_server_v1alpha1_document_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Wikilink) GetTarget() string {
@@ -689,6 +806,13 @@ func (x *Wikilink) GetColumn() int32 {
 	return 0
 }
 
+func (x *Wikilink) GetAnchor() string {
+	if x != nil {
+		return x.Anchor
+	}
+	return ""
+}
+
 // Task represents a task found in a document
 type Task struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -706,7 +830,7 @@ type Task struct {
 
 func (x *Task) Reset() {
 	*x = Task{}
-	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[9]
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -718,7 +842,7 @@ func (x *Task) String() string {
 func (*Task) ProtoMessage() {}
 
 func (x *Task) ProtoReflect() protoreflect.Message {
-	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[9]
+	mi := &file_application_server_v1alpha1_document_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -731,7 +855,7 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Task.ProtoReflect.Descriptor instead.
 func (*Task) Descriptor() ([]byte, []int) {
-	return file_application_server_v1alpha1_document_service_proto_rawDescGZIP(), []int{9}
+	return file_application_server_v1alpha1_document_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Task) GetState() string {
@@ -770,7 +894,12 @@ const file_application_server_v1alpha1_document_service_proto_rawDesc = "" +
 	"\x14ListDocumentsRequest\x12N\n" +
 	"\x06filter\x18\x01 \x01(\v26.notedown.application_server.v1alpha1.FilterExpressionR\x06filter\"e\n" +
 	"\x15ListDocumentsResponse\x12L\n" +
-	"\tdocuments\x18\x01 \x03(\v2..notedown.application_server.v1alpha1.DocumentR\tdocuments\"\xff\x01\n" +
+	"\tdocuments\x18\x01 \x03(\v2..notedown.application_server.v1alpha1.DocumentR\tdocuments\"E\n" +
+	"\x15ExecuteCommandRequest\x12\x18\n" +
+	"\acommand\x18\x01 \x01(\tR\acommand\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"=\n" +
+	"\x16ExecuteCommandResponse\x12#\n" +
+	"\rchanged_paths\x18\x01 \x03(\tR\fchangedPaths\"\xff\x01\n" +
 	"\bDocument\x12\x12\n" +
 	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1a\n" +
 	"\bchecksum\x18\x02 \x01(\tR\bchecksum\x123\n" +
@@ -795,17 +924,18 @@ const file_application_server_v1alpha1_document_service_proto_rawDesc = "" +
 	"\bOrFilter\x12P\n" +
 	"\afilters\x18\x01 \x03(\v26.notedown.application_server.v1alpha1.FilterExpressionR\afilters\"[\n" +
 	"\tNotFilter\x12N\n" +
-	"\x06filter\x18\x01 \x01(\v26.notedown.application_server.v1alpha1.FilterExpressionR\x06filter\"q\n" +
+	"\x06filter\x18\x01 \x01(\v26.notedown.application_server.v1alpha1.FilterExpressionR\x06filter\"\x89\x01\n" +
 	"\bWikilink\x12\x16\n" +
 	"\x06target\x18\x01 \x01(\tR\x06target\x12!\n" +
 	"\fdisplay_text\x18\x02 \x01(\tR\vdisplayText\x12\x12\n" +
 	"\x04line\x18\x03 \x01(\x05R\x04line\x12\x16\n" +
-	"\x06column\x18\x04 \x01(\x05R\x06column\"\\\n" +
+	"\x06column\x18\x04 \x01(\x05R\x06column\x12\x16\n" +
+	"\x06anchor\x18\x05 \x01(\tR\x06anchor\"\\\n" +
 	"\x04Task\x12\x14\n" +
 	"\x05state\x18\x01 \x01(\tR\x05state\x12\x12\n" +
 	"\x04text\x18\x02 \x01(\tR\x04text\x12\x12\n" +
 	"\x04line\x18\x03 \x01(\x05R\x04line\x12\x16\n" +
-	"\x06column\x18\x04 \x01(\x05R\x06column*\xed\x03\n" +
+	"\x06column\x18\x04 \x01(\x05R\x06column*\xd5\x04\n" +
 	"\x10MetadataOperator\x12!\n" +
 	"\x1dMETADATA_OPERATOR_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18METADATA_OPERATOR_EQUALS\x10\x01\x12 \n" +
@@ -821,9 +951,13 @@ const file_application_server_v1alpha1_document_service_proto_rawDesc = "" +
 	"\x12\x1c\n" +
 	"\x18METADATA_OPERATOR_NOT_IN\x10\v\x12\x1c\n" +
 	"\x18METADATA_OPERATOR_EXISTS\x10\f\x12 \n" +
-	"\x1cMETADATA_OPERATOR_NOT_EXISTS\x10\r2\x9c\x01\n" +
+	"\x1cMETADATA_OPERATOR_NOT_EXISTS\x10\r\x12!\n" +
+	"\x1dMETADATA_OPERATOR_REGEX_MATCH\x10\x0e\x12!\n" +
+	"\x1dMETADATA_OPERATOR_DATE_BEFORE\x10\x0f\x12 \n" +
+	"\x1cMETADATA_OPERATOR_DATE_AFTER\x10\x102\xaa\x02\n" +
 	"\x0fDocumentService\x12\x88\x01\n" +
-	"\rListDocuments\x12:.notedown.application_server.v1alpha1.ListDocumentsRequest\x1a;.notedown.application_server.v1alpha1.ListDocumentsResponseBNZLgithub.com/notedownorg/notedown/apis/go/application_server/v1alpha1;v1alpha1b\x06proto3"
+	"\rListDocuments\x12:.notedown.application_server.v1alpha1.ListDocumentsRequest\x1a;.notedown.application_server.v1alpha1.ListDocumentsResponse\x12\x8b\x01\n" +
+	"\x0eExecuteCommand\x12;.notedown.application_server.v1alpha1.ExecuteCommandRequest\x1a<.notedown.application_server.v1alpha1.ExecuteCommandResponseBNZLgithub.com/notedownorg/notedown/apis/go/application_server/v1alpha1;v1alpha1b\x06proto3"
 
 var (
 	file_application_server_v1alpha1_document_service_proto_rawDescOnce sync.Once
@@ -838,41 +972,45 @@ func file_application_server_v1alpha1_document_service_proto_rawDescGZIP() []byt
 }
 
 var file_application_server_v1alpha1_document_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_application_server_v1alpha1_document_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_application_server_v1alpha1_document_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_application_server_v1alpha1_document_service_proto_goTypes = []any{
-	(MetadataOperator)(0),         // 0: notedown.application_server.v1alpha1.MetadataOperator
-	(*ListDocumentsRequest)(nil),  // 1: notedown.application_server.v1alpha1.ListDocumentsRequest
-	(*ListDocumentsResponse)(nil), // 2: notedown.application_server.v1alpha1.ListDocumentsResponse
-	(*Document)(nil),              // 3: notedown.application_server.v1alpha1.Document
-	(*FilterExpression)(nil),      // 4: notedown.application_server.v1alpha1.FilterExpression
-	(*MetadataFilter)(nil),        // 5: notedown.application_server.v1alpha1.MetadataFilter
-	(*AndFilter)(nil),             // 6: notedown.application_server.v1alpha1.AndFilter
-	(*OrFilter)(nil),              // 7: notedown.application_server.v1alpha1.OrFilter
-	(*NotFilter)(nil),             // 8: notedown.application_server.v1alpha1.NotFilter
-	(*Wikilink)(nil),              // 9: notedown.application_server.v1alpha1.Wikilink
-	(*Task)(nil),                  // 10: notedown.application_server.v1alpha1.Task
-	(*structpb.Struct)(nil),       // 11: google.protobuf.Struct
-	(*structpb.Value)(nil),        // 12: google.protobuf.Value
+	(MetadataOperator)(0),          // 0: notedown.application_server.v1alpha1.MetadataOperator
+	(*ListDocumentsRequest)(nil),   // 1: notedown.application_server.v1alpha1.ListDocumentsRequest
+	(*ListDocumentsResponse)(nil),  // 2: notedown.application_server.v1alpha1.ListDocumentsResponse
+	(*ExecuteCommandRequest)(nil),  // 3: notedown.application_server.v1alpha1.ExecuteCommandRequest
+	(*ExecuteCommandResponse)(nil), // 4: notedown.application_server.v1alpha1.ExecuteCommandResponse
+	(*Document)(nil),               // 5: notedown.application_server.v1alpha1.Document
+	(*FilterExpression)(nil),       // 6: notedown.application_server.v1alpha1.FilterExpression
+	(*MetadataFilter)(nil),         // 7: notedown.application_server.v1alpha1.MetadataFilter
+	(*AndFilter)(nil),              // 8: notedown.application_server.v1alpha1.AndFilter
+	(*OrFilter)(nil),               // 9: notedown.application_server.v1alpha1.OrFilter
+	(*NotFilter)(nil),              // 10: notedown.application_server.v1alpha1.NotFilter
+	(*Wikilink)(nil),               // 11: notedown.application_server.v1alpha1.Wikilink
+	(*Task)(nil),                   // 12: notedown.application_server.v1alpha1.Task
+	(*structpb.Struct)(nil),        // 13: google.protobuf.Struct
+	(*structpb.Value)(nil),         // 14: google.protobuf.Value
 }
 var file_application_server_v1alpha1_document_service_proto_depIdxs = []int32{
-	4,  // 0: notedown.application_server.v1alpha1.ListDocumentsRequest.filter:type_name -> notedown.application_server.v1alpha1.FilterExpression
-	3,  // 1: notedown.application_server.v1alpha1.ListDocumentsResponse.documents:type_name -> notedown.application_server.v1alpha1.Document
-	11, // 2: notedown.application_server.v1alpha1.Document.metadata:type_name -> google.protobuf.Struct
-	9,  // 3: notedown.application_server.v1alpha1.Document.wikilinks:type_name -> notedown.application_server.v1alpha1.Wikilink
-	10, // 4: notedown.application_server.v1alpha1.Document.tasks:type_name -> notedown.application_server.v1alpha1.Task
-	5,  // 5: notedown.application_server.v1alpha1.FilterExpression.metadata_filter:type_name -> notedown.application_server.v1alpha1.MetadataFilter
-	6,  // 6: notedown.application_server.v1alpha1.FilterExpression.and_filter:type_name -> notedown.application_server.v1alpha1.AndFilter
-	7,  // 7: notedown.application_server.v1alpha1.FilterExpression.or_filter:type_name -> notedown.application_server.v1alpha1.OrFilter
-	8,  // 8: notedown.application_server.v1alpha1.FilterExpression.not_filter:type_name -> notedown.application_server.v1alpha1.NotFilter
+	6,  // 0: notedown.application_server.v1alpha1.ListDocumentsRequest.filter:type_name -> notedown.application_server.v1alpha1.FilterExpression
+	5,  // 1: notedown.application_server.v1alpha1.ListDocumentsResponse.documents:type_name -> notedown.application_server.v1alpha1.Document
+	13, // 2: notedown.application_server.v1alpha1.Document.metadata:type_name -> google.protobuf.Struct
+	11, // 3: notedown.application_server.v1alpha1.Document.wikilinks:type_name -> notedown.application_server.v1alpha1.Wikilink
+	12, // 4: notedown.application_server.v1alpha1.Document.tasks:type_name -> notedown.application_server.v1alpha1.Task
+	7,  // 5: notedown.application_server.v1alpha1.FilterExpression.metadata_filter:type_name -> notedown.application_server.v1alpha1.MetadataFilter
+	8,  // 6: notedown.application_server.v1alpha1.FilterExpression.and_filter:type_name -> notedown.application_server.v1alpha1.AndFilter
+	9,  // 7: notedown.application_server.v1alpha1.FilterExpression.or_filter:type_name -> notedown.application_server.v1alpha1.OrFilter
+	10, // 8: notedown.application_server.v1alpha1.FilterExpression.not_filter:type_name -> notedown.application_server.v1alpha1.NotFilter
 	0,  // 9: notedown.application_server.v1alpha1.MetadataFilter.operator:type_name -> notedown.application_server.v1alpha1.MetadataOperator
-	12, // 10: notedown.application_server.v1alpha1.MetadataFilter.value:type_name -> google.protobuf.Value
-	4,  // 11: notedown.application_server.v1alpha1.AndFilter.filters:type_name -> notedown.application_server.v1alpha1.FilterExpression
-	4,  // 12: notedown.application_server.v1alpha1.OrFilter.filters:type_name -> notedown.application_server.v1alpha1.FilterExpression
-	4,  // 13: notedown.application_server.v1alpha1.NotFilter.filter:type_name -> notedown.application_server.v1alpha1.FilterExpression
+	14, // 10: notedown.application_server.v1alpha1.MetadataFilter.value:type_name -> google.protobuf.Value
+	6,  // 11: notedown.application_server.v1alpha1.AndFilter.filters:type_name -> notedown.application_server.v1alpha1.FilterExpression
+	6,  // 12: notedown.application_server.v1alpha1.OrFilter.filters:type_name -> notedown.application_server.v1alpha1.FilterExpression
+	6,  // 13: notedown.application_server.v1alpha1.NotFilter.filter:type_name -> notedown.application_server.v1alpha1.FilterExpression
 	1,  // 14: notedown.application_server.v1alpha1.DocumentService.ListDocuments:input_type -> notedown.application_server.v1alpha1.ListDocumentsRequest
-	2,  // 15: notedown.application_server.v1alpha1.DocumentService.ListDocuments:output_type -> notedown.application_server.v1alpha1.ListDocumentsResponse
-	15, // [15:16] is the sub-list for method output_type
-	14, // [14:15] is the sub-list for method input_type
+	3,  // 15: notedown.application_server.v1alpha1.DocumentService.ExecuteCommand:input_type -> notedown.application_server.v1alpha1.ExecuteCommandRequest
+	2,  // 16: notedown.application_server.v1alpha1.DocumentService.ListDocuments:output_type -> notedown.application_server.v1alpha1.ListDocumentsResponse
+	4,  // 17: notedown.application_server.v1alpha1.DocumentService.ExecuteCommand:output_type -> notedown.application_server.v1alpha1.ExecuteCommandResponse
+	16, // [16:18] is the sub-list for method output_type
+	14, // [14:16] is the sub-list for method input_type
 	14, // [14:14] is the sub-list for extension type_name
 	14, // [14:14] is the sub-list for extension extendee
 	0,  // [0:14] is the sub-list for field type_name
@@ -883,7 +1021,7 @@ func file_application_server_v1alpha1_document_service_proto_init() {
 	if File_application_server_v1alpha1_document_service_proto != nil {
 		return
 	}
-	file_application_server_v1alpha1_document_service_proto_msgTypes[3].OneofWrappers = []any{
+	file_application_server_v1alpha1_document_service_proto_msgTypes[5].OneofWrappers = []any{
 		(*FilterExpression_MetadataFilter)(nil),
 		(*FilterExpression_AndFilter)(nil),
 		(*FilterExpression_OrFilter)(nil),
@@ -895,7 +1033,7 @@ func file_application_server_v1alpha1_document_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_application_server_v1alpha1_document_service_proto_rawDesc), len(file_application_server_v1alpha1_document_service_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
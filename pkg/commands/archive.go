@@ -0,0 +1,212 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/notedownorg/notedown/pkg/config"
+)
+
+// archiveHeading is the heading completed tasks are moved under
+const archiveHeading = "## Archive"
+
+var (
+	taskLineRegex = regexp.MustCompile(`^(\s*)(?:[-*+]|\d+[.)])\s+\[([^\]]*)\]`)
+	headingRegex  = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*$`)
+)
+
+// ArchiveCompletedTasks moves completed tasks, together with their nested content,
+// to the end of the document under an "Archive" heading. Tasks inside code fences
+// and tasks that are already archived are left untouched.
+func ArchiveCompletedTasks(content string, cfg *config.Config) string {
+	newline := lineEnding(content)
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	archiveStart, archiveEnd := findArchiveSection(lines)
+	removed := make([]bool, len(lines))
+	var archived []string
+
+	inFence := false
+	fenceMarker := ""
+	for i := 0; i < len(lines); i++ {
+		if marker, ok := fenceDelimiter(lines[i]); ok {
+			if !inFence {
+				inFence, fenceMarker = true, marker
+			} else if marker == fenceMarker {
+				inFence = false
+			}
+			continue
+		}
+		if inFence || (archiveStart != -1 && i > archiveStart && i < archiveEnd) {
+			continue
+		}
+
+		match := taskLineRegex.FindStringSubmatch(lines[i])
+		if match == nil || !isCompletedState(match[2], cfg) {
+			continue
+		}
+
+		// Collect the task line and any more deeply indented continuation lines, including
+		// blank lines followed by further continuation as in loose lists
+		indent := len(match[1])
+		end := i + 1
+		for end < len(lines) {
+			if !isBlank(lines[end]) {
+				if indentWidth(lines[end]) <= indent {
+					break
+				}
+				end++
+				continue
+			}
+			next := end
+			for next < len(lines) && isBlank(lines[next]) {
+				next++
+			}
+			if next == len(lines) || indentWidth(lines[next]) <= indent {
+				break
+			}
+			end = next
+		}
+
+		for j := i; j < end; j++ {
+			removed[j] = true
+			archived = append(archived, lines[j][min(indent, indentWidth(lines[j])):])
+		}
+
+		// Avoid leaving a double blank line where a standalone task block was removed
+		if (i == 0 || isBlank(lines[i-1])) && end < len(lines)-1 && isBlank(lines[end]) {
+			removed[end] = true
+		}
+		i = end - 1
+	}
+
+	if len(archived) == 0 {
+		return content
+	}
+
+	var result []string
+	if archiveStart == -1 {
+		for i, line := range lines {
+			if !removed[i] {
+				result = append(result, line)
+			}
+		}
+		for len(result) > 0 && isBlank(result[len(result)-1]) {
+			result = result[:len(result)-1]
+		}
+		if len(result) > 0 {
+			result = append(result, "")
+		}
+		result = append(result, archiveHeading, "")
+		result = append(result, archived...)
+		return strings.Join(result, newline) + newline
+	}
+
+	// Append after the last non-blank line of the existing archive section
+	insertAt := archiveStart
+	for i := archiveEnd - 1; i > archiveStart; i-- {
+		if !isBlank(lines[i]) {
+			insertAt = i
+			break
+		}
+	}
+	if insertAt == archiveStart {
+		archived = append([]string{""}, archived...)
+	}
+
+	for i, line := range lines {
+		if !removed[i] {
+			result = append(result, line)
+		}
+		if i == insertAt {
+			result = append(result, archived...)
+		}
+	}
+	return strings.Join(result, newline)
+}
+
+// findArchiveSection returns the line index of the archive heading and the index
+// of the first line after its section, or -1 if the document has no archive heading
+func findArchiveSection(lines []string) (int, int) {
+	start, level := -1, 0
+	inFence := false
+	fenceMarker := ""
+	for i, line := range lines {
+		if marker, ok := fenceDelimiter(line); ok {
+			if !inFence {
+				inFence, fenceMarker = true, marker
+			} else if marker == fenceMarker {
+				inFence = false
+			}
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		match := headingRegex.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		if start == -1 {
+			if strings.EqualFold(match[2], "Archive") {
+				start, level = i, len(match[1])
+			}
+			continue
+		}
+		if len(match[1]) <= level {
+			return start, i
+		}
+	}
+	return start, len(lines)
+}
+
+// fenceDelimiter checks if a line opens or closes a fenced code block
+func fenceDelimiter(line string) (string, bool) {
+	trimmed := strings.TrimSpace(line)
+	for _, marker := range []string{"```", "~~~"} {
+		if strings.HasPrefix(trimmed, marker) {
+			return marker, true
+		}
+	}
+	return "", false
+}
+
+// isCompletedState checks if a task state value refers to the completed state, the configured
+// state that "x" resolves to, so every alias of that state also counts as completed
+func isCompletedState(value string, cfg *config.Config) bool {
+	completed := cfg.Tasks.FindState("x")
+	return completed != nil && cfg.Tasks.FindState(value) == completed
+}
+
+// lineEnding returns the line ending used by the content, "\r\n" if any line uses it
+func lineEnding(content string) string {
+	if strings.Contains(content, "\r\n") {
+		return "\r\n"
+	}
+	return "\n"
+}
+
+// isBlank checks if a line contains only whitespace
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+// indentWidth returns the number of leading whitespace characters in a line
+func indentWidth(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " \t"))
+}
@@ -0,0 +1,169 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"testing"
+
+	"github.com/notedownorg/notedown/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveCompletedTasks(t *testing.T) {
+	cfg := config.GetDefaultConfig()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name: "creates archive section",
+			input: `# Tasks
+
+- [ ] Open task
+- [x] Done task
+- [wip] Ongoing task
+`,
+			expected: `# Tasks
+
+- [ ] Open task
+- [wip] Ongoing task
+
+## Archive
+
+- [x] Done task
+`,
+		},
+		{
+			name: "moves nested content with the task",
+			input: `- [x] Done parent
+  - [ ] Child task
+  Continuation line
+- [ ] Open task
+  - [completed] Done child
+`,
+			expected: `- [ ] Open task
+
+## Archive
+
+- [x] Done parent
+  - [ ] Child task
+  Continuation line
+- [completed] Done child
+`,
+		},
+		{
+			name: "appends to existing archive section",
+			input: `# Tasks
+
+- [X] Newly done
+
+## Archive
+
+- [x] Previously done
+
+## Notes
+
+Some notes.
+`,
+			expected: `# Tasks
+
+## Archive
+
+- [x] Previously done
+- [X] Newly done
+
+## Notes
+
+Some notes.
+`,
+		},
+		{
+			name:     "ignores tasks in code fences",
+			input:    "- [ ] Open task\n\n```markdown\n- [x] Example task\n```\n",
+			expected: "- [ ] Open task\n\n```markdown\n- [x] Example task\n```\n",
+		},
+		{
+			name:     "ignores archive headings in code fences",
+			input:    "```\n## Archive\n```\n- [x] d\n",
+			expected: "```\n## Archive\n```\n\n## Archive\n\n- [x] d\n",
+		},
+		{
+			name:     "moves continuation paragraphs of loose list items",
+			input:    "- [x] parent\n\n  continuation para\n- [ ] next\n",
+			expected: "- [ ] next\n\n## Archive\n\n- [x] parent\n\n  continuation para\n",
+		},
+		{
+			name:     "keeps CRLF line endings",
+			input:    "# Tasks\r\n\r\n- [ ] a\r\n- [x] b\r\n",
+			expected: "# Tasks\r\n\r\n- [ ] a\r\n\r\n## Archive\r\n\r\n- [x] b\r\n",
+		},
+		{
+			name:     "keeps CRLF line endings in existing archive section",
+			input:    "- [x] a\r\n\r\n## Archive\r\n\r\n- [x] b\r\n",
+			expected: "## Archive\r\n\r\n- [x] b\r\n- [x] a\r\n",
+		},
+		{
+			name:     "no completed tasks leaves content unchanged",
+			input:    "- [ ] Open task\n- Regular item",
+			expected: "- [ ] Open task\n- Regular item",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ArchiveCompletedTasks(tt.input, cfg))
+		})
+	}
+}
+
+func TestArchiveCompletedTasksCustomDoneState(t *testing.T) {
+	cfg := &config.Config{
+		Tasks: config.TasksConfig{
+			States: []config.TaskState{
+				{Value: " ", Name: "todo"},
+				{Value: "done", Name: "done", Aliases: []string{"complete", "x"}},
+			},
+		},
+	}
+
+	input := "- [x] a\n- [done] b\n- [complete] c\n- [ ] d\n"
+	expected := "- [ ] d\n\n## Archive\n\n- [x] a\n- [done] b\n- [complete] c\n"
+	assert.Equal(t, expected, ArchiveCompletedTasks(input, cfg))
+
+	// Without a state that "x" resolves to nothing counts as completed
+	cfg.Tasks.States = cfg.Tasks.States[:1]
+	assert.Equal(t, input, ArchiveCompletedTasks(input, cfg))
+}
+
+func TestApply(t *testing.T) {
+	t.Run("known command", func(t *testing.T) {
+		assert.True(t, IsKnown(ArchiveCompletedTasksCommand))
+
+		result, err := Apply(ArchiveCompletedTasksCommand, "- [x] Done\n", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "## Archive\n\n- [x] Done\n", result)
+	})
+
+	t.Run("unknown command", func(t *testing.T) {
+		assert.False(t, IsKnown("notedown.unknown"))
+
+		_, err := Apply("notedown.unknown", "", nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown command")
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"fmt"
+
+	"github.com/notedownorg/notedown/pkg/config"
+)
+
+// Command identifiers shared by the language server and the document service
+const (
+	ArchiveCompletedTasksCommand = "notedown.archiveCompletedTasks"
+	NormalizeTaskStatesCommand   = "notedown.normalizeTaskStates"
+	NormalizeTypographyCommand   = "notedown.normalizeTypography"
+	NormalizeFrontmatterCommand  = "notedown.normalizeFrontmatter"
+	TidyListsCommand             = "notedown.tidyLists"
+	RenumberListsCommand         = "notedown.renumberLists"
+)
+
+// Command rewrites the content of a single document
+type Command func(content string, cfg *config.Config) string
+
+var registry = map[string]Command{
+	ArchiveCompletedTasksCommand: ArchiveCompletedTasks,
+	NormalizeTaskStatesCommand:   NormalizeTaskStates,
+	NormalizeTypographyCommand:   NormalizeTypography,
+	NormalizeFrontmatterCommand:  NormalizeFrontmatter,
+	TidyListsCommand:             TidyLists,
+	RenumberListsCommand:         RenumberLists,
+}
+
+// IsKnown checks if a command is registered under the given identifier
+func IsKnown(name string) bool {
+	_, ok := registry[name]
+	return ok
+}
+
+// Apply runs the named command against document content and returns the rewritten content
+func Apply(name, content string, cfg *config.Config) (string, error) {
+	command, ok := registry[name]
+	if !ok {
+		return "", fmt.Errorf("unknown command: %s", name)
+	}
+
+	if cfg == nil {
+		cfg = config.GetDefaultConfig()
+	}
+
+	return command(content, cfg), nil
+}
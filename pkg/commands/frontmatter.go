@@ -0,0 +1,132 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/notedownorg/notedown/pkg/config"
+	"gopkg.in/yaml.v3"
+)
+
+// NormalizeFrontmatter rewrites the frontmatter block to the configured schema: known fields are
+// ordered as configured, missing fields with a default or marked required are added, and unknown
+// fields are kept after the known ones. Notes without frontmatter are left alone unless the schema
+// requires frontmatter, and frontmatter that is not a YAML mapping is never changed.
+func NormalizeFrontmatter(content string, cfg *config.Config) string {
+	schema := cfg.Frontmatter
+	if len(schema.Fields) == 0 && !schema.Require {
+		return content
+	}
+
+	newline := lineEnding(content)
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	end := frontmatterEnd(lines)
+
+	mapping := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	body := lines
+	if end > 0 {
+		var doc yaml.Node
+		if err := yaml.Unmarshal([]byte(strings.Join(lines[1:end-1], "\n")), &doc); err != nil {
+			return content
+		}
+		if doc.Kind == yaml.DocumentNode {
+			if len(doc.Content) != 1 || doc.Content[0].Kind != yaml.MappingNode {
+				return content
+			}
+			mapping = doc.Content[0]
+		}
+		body = lines[end:]
+	} else if !schema.Require {
+		return content
+	}
+
+	changed, err := canonicalizeFrontmatter(mapping, schema.Fields)
+	if err != nil || (!changed && end > 0) {
+		return content
+	}
+
+	var encoded bytes.Buffer
+	if len(mapping.Content) > 0 {
+		encoder := yaml.NewEncoder(&encoded)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(mapping); err != nil {
+			return content
+		}
+		if err := encoder.Close(); err != nil {
+			return content
+		}
+	}
+
+	frontmatter := "---\n" + encoded.String() + "---"
+	result := frontmatter + "\n" + strings.Join(body, "\n")
+	return strings.ReplaceAll(result, "\n", newline)
+}
+
+// canonicalizeFrontmatter reorders and fills the key/value pairs of a mapping node according to the
+// schema fields, reporting whether the mapping changed
+func canonicalizeFrontmatter(mapping *yaml.Node, fields []config.FrontmatterField) (bool, error) {
+	used := make([]bool, len(mapping.Content)/2)
+	ordered := make([]*yaml.Node, 0, len(mapping.Content))
+	changed := false
+
+	for _, field := range fields {
+		index := -1
+		for i := 0; i < len(used); i++ {
+			if mapping.Content[2*i].Value == field.Name {
+				index = i
+				break
+			}
+		}
+
+		if index >= 0 {
+			ordered = append(ordered, mapping.Content[2*index], mapping.Content[2*index+1])
+			used[index] = true
+			continue
+		}
+		if field.Default == nil && !field.Required {
+			continue
+		}
+
+		value := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null"}
+		if field.Default != nil {
+			if err := value.Encode(field.Default); err != nil {
+				return false, err
+			}
+		}
+		key := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: field.Name}
+		ordered = append(ordered, key, value)
+		changed = true
+	}
+
+	for i, isUsed := range used {
+		if !isUsed {
+			ordered = append(ordered, mapping.Content[2*i], mapping.Content[2*i+1])
+		}
+	}
+
+	if !changed {
+		for i := range ordered {
+			if ordered[i] != mapping.Content[i] {
+				changed = true
+				break
+			}
+		}
+	}
+
+	mapping.Content = ordered
+	return changed, nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"testing"
+
+	"github.com/notedownorg/notedown/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeFrontmatter(t *testing.T) {
+	fields := []config.FrontmatterField{
+		{Name: "title", Required: true},
+		{Name: "status", Default: "draft"},
+		{Name: "tags", Default: []any{}},
+		{Name: "author"},
+	}
+
+	tests := []struct {
+		name     string
+		require  bool
+		input    string
+		expected string
+	}{
+		{
+			name: "reorders fields and keeps unknown fields",
+			input: `---
+tags: [go, notes]
+custom: kept # comment
+status: active
+title: "My Note"
+---
+# Body
+`,
+			expected: `---
+title: "My Note"
+status: active
+tags: [go, notes]
+custom: kept # comment
+---
+# Body
+`,
+		},
+		{
+			name: "injects defaults for missing fields",
+			input: `---
+title: Existing
+---
+Body`,
+			expected: `---
+title: Existing
+status: draft
+tags: []
+---
+Body`,
+		},
+		{
+			name: "adds required fields without default",
+			input: `---
+status: done
+tags: []
+---
+`,
+			expected: `---
+title:
+status: done
+tags: []
+---
+`,
+		},
+		{
+			name: "canonical frontmatter is unchanged",
+			input: `---
+title: Same
+status:   draft
+tags:
+    - a
+---
+`,
+			expected: `---
+title: Same
+status:   draft
+tags:
+    - a
+---
+`,
+		},
+		{
+			name:     "notes without frontmatter are left alone",
+			input:    "# No frontmatter\n",
+			expected: "# No frontmatter\n",
+		},
+		{
+			name:     "required frontmatter is added",
+			require:  true,
+			input:    "# No frontmatter\n",
+			expected: "---\ntitle:\nstatus: draft\ntags: []\n---\n# No frontmatter\n",
+		},
+		{
+			name:     "empty frontmatter is filled",
+			input:    "---\n---\nBody",
+			expected: "---\ntitle:\nstatus: draft\ntags: []\n---\nBody",
+		},
+		{
+			name:     "non-mapping frontmatter is unchanged",
+			input:    "---\n- a\n- b\n---\nBody",
+			expected: "---\n- a\n- b\n---\nBody",
+		},
+		{
+			name:     "keeps CRLF line endings",
+			input:    "---\r\nstatus: done\r\ntitle: T\r\n---\r\nBody\r\n",
+			expected: "---\r\ntitle: T\r\nstatus: done\r\ntags: []\r\n---\r\nBody\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.GetDefaultConfig()
+			cfg.Frontmatter = config.FrontmatterConfig{Fields: fields, Require: tt.require}
+			assert.Equal(t, tt.expected, NormalizeFrontmatter(tt.input, cfg))
+		})
+	}
+}
+
+func TestNormalizeFrontmatterWithoutSchema(t *testing.T) {
+	input := "---\nb: 1\na: 2\n---\n"
+	assert.Equal(t, input, NormalizeFrontmatter(input, config.GetDefaultConfig()))
+
+	assert.True(t, IsKnown(NormalizeFrontmatterCommand))
+	result, err := Apply(NormalizeFrontmatterCommand, input, nil)
+	require.NoError(t, err)
+	assert.Equal(t, input, result)
+}
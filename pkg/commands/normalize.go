@@ -0,0 +1,58 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"strings"
+
+	"github.com/notedownorg/notedown/pkg/config"
+)
+
+// NormalizeTaskStates rewrites task state aliases to their configured canonical value,
+// e.g. "- [completed]" becomes "- [x]". Unknown states and tasks inside code fences are
+// left untouched.
+func NormalizeTaskStates(content string, cfg *config.Config) string {
+	lines := strings.Split(content, "\n")
+
+	inFence := false
+	fenceMarker := ""
+	for i, line := range lines {
+		if marker, ok := fenceDelimiter(line); ok {
+			if !inFence {
+				inFence, fenceMarker = true, marker
+			} else if marker == fenceMarker {
+				inFence = false
+			}
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		match := taskLineRegex.FindStringSubmatchIndex(line)
+		if match == nil {
+			continue
+		}
+
+		value := line[match[4]:match[5]]
+		state := cfg.Tasks.FindState(value)
+		if state == nil || state.Value == value {
+			continue
+		}
+		lines[i] = line[:match[4]] + state.Value + line[match[5]:]
+	}
+
+	return strings.Join(lines, "\n")
+}
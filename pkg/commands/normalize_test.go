@@ -0,0 +1,81 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"testing"
+
+	"github.com/notedownorg/notedown/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTaskStates(t *testing.T) {
+	cfg := &config.Config{
+		Tasks: config.TasksConfig{
+			States: []config.TaskState{
+				{Value: " ", Name: "todo"},
+				{Value: "done", Name: "done", Aliases: []string{"complete", "x"}},
+				{Value: "/", Name: "in-progress", Aliases: []string{"wip"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "rewrites aliases to canonical values",
+			input:    "- [complete] First\n- [x] Second\n- [wip] Third\n",
+			expected: "- [done] First\n- [done] Second\n- [/] Third\n",
+		},
+		{
+			name:     "keeps canonical and unknown states",
+			input:    "- [ ] Open\n- [done] Finished\n- [maybe] Unknown\n",
+			expected: "- [ ] Open\n- [done] Finished\n- [maybe] Unknown\n",
+		},
+		{
+			name:     "preserves indentation, markers and trailing text",
+			input:    "* [wip] Parent\n  1. [complete] Child [with] brackets\n",
+			expected: "* [/] Parent\n  1. [done] Child [with] brackets\n",
+		},
+		{
+			name:     "ignores tasks in code fences",
+			input:    "~~~\n- [complete] Example\n~~~\n- [complete] Real\n",
+			expected: "~~~\n- [complete] Example\n~~~\n- [done] Real\n",
+		},
+		{
+			name:     "ignores non-task lines",
+			input:    "Text with [complete] brackets\n- Plain item",
+			expected: "Text with [complete] brackets\n- Plain item",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeTaskStates(tt.input, cfg))
+		})
+	}
+
+	t.Run("registered command", func(t *testing.T) {
+		assert.True(t, IsKnown(NormalizeTaskStatesCommand))
+
+		result, err := Apply(NormalizeTaskStatesCommand, "- [X] Done\n", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "- [x] Done\n", result)
+	})
+}
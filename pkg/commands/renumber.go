@@ -0,0 +1,128 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/notedownorg/notedown/pkg/config"
+)
+
+// renumberLevel is an open list item and the list it belongs to
+type renumberLevel struct {
+	contentCol int    // Column of the item content
+	delimiter  string // Bullet character, or "." or ")" for ordered items
+	ordered    bool
+	next       int // Number the next item of an ordered list should have
+}
+
+// RenumberLists rewrites the numbers of ordered list items so every list, including nested
+// lists, counts up by one from the number of its first item: "1, 1, 1" becomes "1, 2, 3" and
+// a list starting at 3 continues 4, 5. Only the numbers of mis-numbered items change, bullet
+// and task lists, indentation, frontmatter and code fences are left untouched.
+func RenumberLists(content string, cfg *config.Config) string {
+	newline := lineEnding(content)
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	var stack []renumberLevel
+	inFence := false
+	fenceMarker := ""
+	prevBlank := false
+	for i := frontmatterEnd(lines); i < len(lines); i++ {
+		line := lines[i]
+		indent := indentColumns(line)
+
+		if marker, ok := fenceDelimiter(line); ok {
+			if !inFence {
+				inFence, fenceMarker = true, marker
+				stack, _ = closeRenumberLevels(stack, indent)
+			} else if marker == fenceMarker {
+				inFence = false
+			}
+			prevBlank = false
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		if isBlank(line) {
+			prevBlank = true
+			continue
+		}
+		wasBlank := prevBlank
+		prevBlank = false
+
+		match := listItemRegex.FindStringSubmatchIndex(line)
+		if match == nil || thematicBreakRegex.MatchString(line) {
+			if wasBlank {
+				stack, _ = closeRenumberLevels(stack, indent)
+			}
+			if len(stack) > 0 && indent < stack[0].contentCol && (thematicBreakRegex.MatchString(line) || headingRegex.MatchString(line)) {
+				stack = nil // A heading or thematic break ends the list
+			}
+			continue
+		}
+
+		var previous *renumberLevel
+		stack, previous = closeRenumberLevels(stack, indent)
+		if len(stack) == 0 && indent >= 4 {
+			continue // An indented code block, not a list item
+		}
+
+		marker := line[match[4]:match[5]]
+		level := renumberLevel{
+			contentCol: contentColumn(indent+len(marker), subgroup(line, match, 3)),
+			delimiter:  marker[len(marker)-1:],
+			ordered:    marker[0] >= '0' && marker[0] <= '9',
+		}
+
+		if level.ordered {
+			number, _ := strconv.Atoi(marker[:len(marker)-1])
+			if previous != nil && previous.ordered && previous.delimiter == level.delimiter {
+				// The item continues the list of its previous sibling
+				if number != previous.next {
+					number = previous.next
+					lines[i] = line[:match[4]] + strconv.Itoa(number) + line[match[5]-1:]
+				}
+			}
+			level.next = number + 1
+		}
+		stack = append(stack, level)
+	}
+
+	return strings.Join(lines, newline)
+}
+
+// closeRenumberLevels closes the open list items whose content a line with the given indentation is
+// not part of, returning the closed item at the line's own nesting level as its previous sibling
+func closeRenumberLevels(stack []renumberLevel, indent int) ([]renumberLevel, *renumberLevel) {
+	var previous *renumberLevel
+	for len(stack) > 0 && indent < stack[len(stack)-1].contentCol {
+		closed := stack[len(stack)-1]
+		previous = &closed
+		stack = stack[:len(stack)-1]
+	}
+	return stack, previous
+}
+
+// subgroup returns the text of a submatch, or "" if the group did not participate in the match
+func subgroup(s string, match []int, group int) string {
+	if match[2*group] < 0 {
+		return ""
+	}
+	return s[match[2*group]:match[2*group+1]]
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"testing"
+
+	"github.com/notedownorg/notedown/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenumberLists(t *testing.T) {
+	cfg := config.GetDefaultConfig()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "repeated numbers",
+			input:    "1. a\n1. b\n1. c\n",
+			expected: "1. a\n2. b\n3. c\n",
+		},
+		{
+			name:     "gaps",
+			input:    "1. a\n5. b\n9. c\n",
+			expected: "1. a\n2. b\n3. c\n",
+		},
+		{
+			name:     "keeps the starting number",
+			input:    "3. a\n3. b\n7. c\n",
+			expected: "3. a\n4. b\n5. c\n",
+		},
+		{
+			name: "nested ordered lists",
+			input: "1. a\n" +
+				"   1. a1\n" +
+				"   1. a2\n" +
+				"      4. deep\n" +
+				"      4. deeper\n" +
+				"   7. a3\n" +
+				"1. b\n" +
+				"   - bullet\n" +
+				"   - [ ] task\n" +
+				"1. c\n",
+			expected: "1. a\n" +
+				"   1. a1\n" +
+				"   2. a2\n" +
+				"      4. deep\n" +
+				"      5. deeper\n" +
+				"   3. a3\n" +
+				"2. b\n" +
+				"   - bullet\n" +
+				"   - [ ] task\n" +
+				"3. c\n",
+		},
+		{
+			name:     "loose lists and continuation paragraphs",
+			input:    "1. a\n\n   more about a\n\n1. b\n1) other list\n1) continues\n",
+			expected: "1. a\n\n   more about a\n\n2. b\n1) other list\n2) continues\n",
+		},
+		{
+			name:     "lists that restart",
+			input:    "1. a\n2. b\n\nParagraph\n\n1. c\n1. d\n\n# Heading\n5. e\n5. f\n",
+			expected: "1. a\n2. b\n\nParagraph\n\n1. c\n2. d\n\n# Heading\n5. e\n6. f\n",
+		},
+		{
+			name:     "a bullet between ordered items starts a new list",
+			input:    "1. a\n- b\n1. c\n",
+			expected: "1. a\n- b\n1. c\n",
+		},
+		{
+			name:     "leaves code fences and frontmatter untouched",
+			input:    "---\nsteps: 1\n---\n1. a\n\n```\n1. fenced\n1. fenced\n```\n1. new list\n1. b\n",
+			expected: "---\nsteps: 1\n---\n1. a\n\n```\n1. fenced\n1. fenced\n```\n1. new list\n2. b\n",
+		},
+		{
+			name:     "keeps indentation, spacing and CRLF line endings",
+			input:    " 1.  a\r\n 1.  b  \r\n",
+			expected: " 1.  a\r\n 2.  b  \r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, RenumberLists(tt.input, cfg))
+		})
+	}
+}
+
+func TestRenumberListsCommand(t *testing.T) {
+	assert.True(t, IsKnown(RenumberListsCommand))
+
+	result, err := Apply(RenumberListsCommand, "2. a\n2. b\n", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "2. a\n3. b\n", result)
+}
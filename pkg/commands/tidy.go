@@ -0,0 +1,211 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/notedownorg/notedown/pkg/config"
+)
+
+var (
+	// listItemRegex matches a list item line, capturing its indentation, marker, the spacing after
+	// the marker and the content without trailing whitespace
+	listItemRegex = regexp.MustCompile(`^([ \t]*)([-*+]|\d{1,9}[.)])(?:([ \t]+)(.*?))?[ \t]*$`)
+
+	// checkboxRegex matches a task checkbox at the start of list item content and the text after it
+	checkboxRegex = regexp.MustCompile(`^\[([^\]]*)\](?:[ \t]+(.*))?$`)
+)
+
+// tidyLevel is an open list item with its original and tidied positions
+type tidyLevel struct {
+	contentCol    int // Original column of the item content
+	newContentCol int // Column of the item content after tidying
+	childIndent   int // Indentation of nested items after tidying
+}
+
+// TidyLists normalizes the whitespace of every list in the document: trailing whitespace is removed
+// (two-space hard line breaks are kept), runs of spaces after list markers and task checkboxes are
+// collapsed to one, and nested items are indented by the configured unit, or up to the parent's
+// content when its marker is wider. Nesting follows CommonMark, so the structure of the lists does not
+// change. Frontmatter and code fences are left untouched.
+func TidyLists(content string, cfg *config.Config) string {
+	unit := cfg.Lists.IndentOrDefault()
+	newline := lineEnding(content)
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	var stack []tidyLevel
+	inFence := false
+	fenceMarker := ""
+	prevBlank := false
+	for i := frontmatterEnd(lines); i < len(lines); i++ {
+		line := lines[i]
+		indent := indentColumns(line)
+
+		if marker, ok := fenceDelimiter(line); ok {
+			if !inFence {
+				inFence, fenceMarker = true, marker
+				stack = closeLevels(stack, indent)
+			} else if marker == fenceMarker {
+				inFence = false
+			}
+			prevBlank = false
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		if isBlank(line) {
+			if len(stack) > 0 {
+				lines[i] = ""
+			}
+			prevBlank = true
+			continue
+		}
+		wasBlank := prevBlank
+		prevBlank = false
+
+		if match := listItemRegex.FindStringSubmatch(line); match != nil && !thematicBreakRegex.MatchString(line) {
+			stack = closeLevels(stack, indent)
+			if len(stack) == 0 && indent >= 4 {
+				continue // An indented code block, not a list item
+			}
+
+			newIndent := 0
+			if len(stack) > 0 {
+				newIndent = stack[len(stack)-1].childIndent
+			}
+
+			marker, text := match[2], tidyItemText(match[4], cfg)
+			tidied := strings.Repeat(" ", newIndent) + marker
+			if text != "" {
+				tidied += " " + text
+			}
+			if text != "" && hasHardBreak(lines, i) {
+				tidied += "  "
+			}
+			lines[i] = tidied
+
+			contentCol := contentColumn(indent+len(marker), match[3])
+			newContentCol := newIndent + len(marker) + 1
+			stack = append(stack, tidyLevel{
+				contentCol:    contentCol,
+				newContentCol: newContentCol,
+				childIndent:   max(newIndent+unit, newContentCol),
+			})
+			continue
+		}
+
+		if len(stack) == 0 {
+			continue
+		}
+
+		// After a blank line a line only continues the items whose content it is indented to
+		if wasBlank {
+			stack = closeLevels(stack, indent)
+			if len(stack) == 0 {
+				continue
+			}
+		}
+
+		if indent < stack[0].contentCol && (thematicBreakRegex.MatchString(line) || headingRegex.MatchString(line)) {
+			stack = nil // A heading or thematic break ends the list
+			continue
+		}
+
+		text := strings.TrimRight(line, " \t")
+		if hasHardBreak(lines, i) {
+			text += "  "
+		}
+		top := stack[len(stack)-1]
+		if indent >= top.contentCol {
+			// Keep the indentation relative to the item content as the item moves
+			text = strings.Repeat(" ", indent-top.contentCol+top.newContentCol) + strings.TrimLeft(text, " \t")
+		}
+		lines[i] = text
+	}
+
+	return strings.Join(lines, newline)
+}
+
+// closeLevels closes the open list items whose content a line with the given indentation is not part of
+func closeLevels(stack []tidyLevel, indent int) []tidyLevel {
+	for len(stack) > 0 && indent < stack[len(stack)-1].contentCol {
+		stack = stack[:len(stack)-1]
+	}
+	return stack
+}
+
+// tidyItemText collapses the spacing after a configured task checkbox at the start of list item content
+func tidyItemText(text string, cfg *config.Config) string {
+	match := checkboxRegex.FindStringSubmatch(text)
+	if match == nil || cfg.Tasks.FindState(match[1]) == nil {
+		return text
+	}
+	if match[2] == "" {
+		return "[" + match[1] + "]"
+	}
+	return "[" + match[1] + "] " + match[2]
+}
+
+// hasHardBreak checks if the line at the given index ends in a two-space hard line break, which
+// requires the next line to continue the paragraph
+func hasHardBreak(lines []string, index int) bool {
+	return strings.HasSuffix(lines[index], "  ") && index+1 < len(lines) && continuesParagraph(lines[index+1])
+}
+
+// continuesParagraph checks if a line can continue the paragraph of the line before it
+func continuesParagraph(line string) bool {
+	if isBlank(line) || listItemRegex.MatchString(line) || thematicBreakRegex.MatchString(line) || headingRegex.MatchString(line) {
+		return false
+	}
+	_, isFence := fenceDelimiter(line)
+	return !isFence
+}
+
+// contentColumn returns the column of list item content that follows the given spacing after a
+// marker ending at markerEnd, content after five or more columns of spacing starts one past the marker
+func contentColumn(markerEnd int, spacing string) int {
+	column := markerEnd
+	for _, c := range spacing {
+		if c == '\t' {
+			column += 4 - column%4
+		} else {
+			column++
+		}
+	}
+	if column == markerEnd || column-markerEnd > 4 {
+		return markerEnd + 1
+	}
+	return column
+}
+
+// indentColumns returns the width of a line's leading whitespace, expanding tabs to multiples of four
+func indentColumns(line string) int {
+	columns := 0
+	for _, c := range line {
+		switch c {
+		case ' ':
+			columns++
+		case '\t':
+			columns += 4 - columns%4
+		default:
+			return columns
+		}
+	}
+	return columns
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"testing"
+
+	"github.com/notedownorg/notedown/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTidyLists(t *testing.T) {
+	tests := []struct {
+		name     string
+		indent   int
+		input    string
+		expected string
+	}{
+		{
+			name: "messy list",
+			input: "# Tasks   \n" +
+				"\n" +
+				"-  [ ]    Buy milk   \n" +
+				"   - [x]  Nested done\t\n" +
+				"         - deeper item \n" +
+				"   -   sibling\n" +
+				"*  second list item\n" +
+				"  \n" +
+				"Paragraph after   \n",
+			expected: "# Tasks   \n" +
+				"\n" +
+				"- [ ] Buy milk\n" +
+				"  - [x] Nested done\n" +
+				"    - deeper item\n" +
+				"  - sibling\n" +
+				"* second list item\n" +
+				"\n" +
+				"Paragraph after   \n",
+		},
+		{
+			name: "leaves code fences untouched",
+			input: "- item  \n" +
+				"\n" +
+				"```markdown\n" +
+				"-    fenced   item   \n" +
+				"     - nested   \n" +
+				"```\n" +
+				"~~~\n" +
+				"*   also kept  \n" +
+				"~~~\n",
+			expected: "- item\n" +
+				"\n" +
+				"```markdown\n" +
+				"-    fenced   item   \n" +
+				"     - nested   \n" +
+				"```\n" +
+				"~~~\n" +
+				"*   also kept  \n" +
+				"~~~\n",
+		},
+		{
+			name:     "keeps items nested under wide ordered markers",
+			input:    "1. First\n   - child\n10.  Tenth\n      - child\n",
+			expected: "1. First\n   - child\n10. Tenth\n    - child\n",
+		},
+		{
+			name:     "uses the configured indent",
+			indent:   4,
+			input:    "- a\n  - b\n    - c\n",
+			expected: "- a\n    - b\n        - c\n",
+		},
+		{
+			name:     "moves continuation lines with their item",
+			input:    "- a\n     - b\n       continued\n\n       second paragraph\n",
+			expected: "- a\n  - b\n    continued\n\n    second paragraph\n",
+		},
+		{
+			name:     "keeps hard line breaks",
+			input:    "- first line  \n  second line   \n- next\n",
+			expected: "- first line  \n  second line\n- next\n",
+		},
+		{
+			name:     "follows CommonMark nesting",
+			input:    "- a\n - b\n\n    paragraph of b\n-    [ ] c\n   - sibling of c\n",
+			expected: "- a\n- b\n\n   paragraph of b\n- [ ] c\n- sibling of c\n",
+		},
+		{
+			name:     "leaves unconfigured brackets and thematic breaks alone",
+			input:    "- [maybe]   text\n- - -\n",
+			expected: "- [maybe]   text\n- - -\n",
+		},
+		{
+			name:     "keeps CRLF line endings",
+			input:    "-   a  \r\n    - b\r\n",
+			expected: "- a\r\n  - b\r\n",
+		},
+		{
+			name:     "tidy list is unchanged",
+			input:    "- [ ] a\n  - b\n\n1. one\n2. two\n",
+			expected: "- [ ] a\n  - b\n\n1. one\n2. two\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.GetDefaultConfig()
+			cfg.Lists.Indent = tt.indent
+			assert.Equal(t, tt.expected, TidyLists(tt.input, cfg))
+		})
+	}
+}
+
+func TestTidyListsCommand(t *testing.T) {
+	assert.True(t, IsKnown(TidyListsCommand))
+
+	result, err := Apply(TidyListsCommand, "-  a   \n", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "- a\n", result)
+}
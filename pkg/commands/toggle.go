@@ -0,0 +1,90 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/notedownorg/notedown/pkg/config"
+)
+
+var (
+	bulletLineRegex    = regexp.MustCompile(`^(\s*(?:[-*+]|\d+[.)]))(\s+|$)`)
+	thematicBreakRegex = regexp.MustCompile(`^\s*(?:(?:-\s*){3,}|(?:\*\s*){3,}|(?:_\s*){3,})$`)
+)
+
+// ToggleTaskMarker rewrites the list item on the given 1-based line, turning "- item" into
+// "- [ ] item" and "- [x] item" back into "- item". Indentation and the rest of the line are
+// preserved. Lines that are not list items, thematic breaks such as "- - -", and lines inside
+// a code fence are left untouched, as are items with an unconfigured state like "- [maybe] item".
+func ToggleTaskMarker(content string, line int, cfg *config.Config) string {
+	lines := strings.Split(content, "\n")
+	if line < 1 || line > len(lines) || inCodeFence(lines, line-1) {
+		return content
+	}
+
+	if cfg == nil {
+		cfg = config.GetDefaultConfig()
+	}
+
+	// Match without the carriage return of CRLF line endings and restore it afterwards
+	current, lineEnd := lines[line-1], ""
+	if strings.HasSuffix(current, "\r") {
+		current, lineEnd = current[:len(current)-1], "\r"
+	}
+	if thematicBreakRegex.MatchString(current) {
+		return content
+	}
+
+	if match := taskLineRegex.FindStringSubmatchIndex(current); match != nil {
+		// Text directly after the brackets, e.g. a link, means they are not a checkbox
+		if rest := current[match[1]:]; rest == "" || rest[0] == ' ' || rest[0] == '\t' {
+			if cfg.Tasks.FindState(current[match[4]:match[5]]) == nil {
+				return content
+			}
+			lines[line-1] = strings.TrimRight(current[:match[4]-1], " \t") + rest + lineEnd
+			return strings.Join(lines, "\n")
+		}
+	}
+
+	match := bulletLineRegex.FindStringSubmatchIndex(current)
+	if match == nil {
+		return content
+	}
+	lines[line-1] = current[:match[3]] + " [ ] " + current[match[1]:] + lineEnd
+	return strings.Join(lines, "\n")
+}
+
+// inCodeFence checks if the line at the given index is inside or delimits a fenced code block
+func inCodeFence(lines []string, index int) bool {
+	inFence := false
+	fenceMarker := ""
+	for i := 0; i <= index; i++ {
+		marker, ok := fenceDelimiter(lines[i])
+		if !ok {
+			continue
+		}
+		if i == index {
+			return true
+		}
+		if !inFence {
+			inFence, fenceMarker = true, marker
+		} else if marker == fenceMarker {
+			inFence = false
+		}
+	}
+	return inFence
+}
@@ -0,0 +1,154 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"testing"
+
+	"github.com/notedownorg/notedown/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToggleTaskMarker(t *testing.T) {
+	cfg := config.GetDefaultConfig()
+
+	tests := []struct {
+		name     string
+		input    string
+		line     int
+		expected string
+	}{
+		{
+			name:     "bullet to task",
+			input:    "- Buy milk",
+			line:     1,
+			expected: "- [ ] Buy milk",
+		},
+		{
+			name:     "open task to bullet",
+			input:    "- [ ] Buy milk",
+			line:     1,
+			expected: "- Buy milk",
+		},
+		{
+			name:     "completed task to bullet keeps fields",
+			input:    "- [x] Buy milk due:2025-01-10 #errand",
+			line:     1,
+			expected: "- Buy milk due:2025-01-10 #errand",
+		},
+		{
+			name:     "alias state to bullet",
+			input:    "* [wip] Draft",
+			line:     1,
+			expected: "* Draft",
+		},
+		{
+			name:     "indented item only changes the target line",
+			input:    "- Parent\n    - Child\n- Sibling",
+			line:     2,
+			expected: "- Parent\n    - [ ] Child\n- Sibling",
+		},
+		{
+			name:     "indented task to bullet",
+			input:    "- Parent\n\t1. [x] Child",
+			line:     2,
+			expected: "- Parent\n\t1. Child",
+		},
+		{
+			name:     "empty bullet",
+			input:    "-",
+			line:     1,
+			expected: "- [ ] ",
+		},
+		{
+			name:     "link at the start of a bullet is not a checkbox",
+			input:    "- [link](https://example.com)",
+			line:     1,
+			expected: "- [ ] [link](https://example.com)",
+		},
+		{
+			name:     "unconfigured state is left unchanged",
+			input:    "- [maybe] x",
+			line:     1,
+			expected: "- [maybe] x",
+		},
+		{
+			name:     "thematic break is not a bullet",
+			input:    "Text\n\n- - -\n\n***\n---",
+			line:     3,
+			expected: "Text\n\n- - -\n\n***\n---",
+		},
+		{
+			name:     "starred thematic break is not a bullet",
+			input:    "* * *",
+			line:     1,
+			expected: "* * *",
+		},
+		{
+			name:     "bullet starting with a dash is still a bullet",
+			input:    "- - item",
+			line:     1,
+			expected: "- [ ] - item",
+		},
+		{
+			name:     "not a list item",
+			input:    "Plain paragraph",
+			line:     1,
+			expected: "Plain paragraph",
+		},
+		{
+			name:     "inside a code fence",
+			input:    "```\n- item\n```",
+			line:     2,
+			expected: "```\n- item\n```",
+		},
+		{
+			name:     "empty task with CRLF line endings",
+			input:    "- [ ]\r\n- next\r\n",
+			line:     1,
+			expected: "-\r\n- next\r\n",
+		},
+		{
+			name:     "task with CRLF line endings",
+			input:    "- [x] Done\r\n- next\r\n",
+			line:     1,
+			expected: "- Done\r\n- next\r\n",
+		},
+		{
+			name:     "bullet with CRLF line endings",
+			input:    "- first\r\n- item\r\n",
+			line:     2,
+			expected: "- first\r\n- [ ] item\r\n",
+		},
+		{
+			name:     "thematic break with CRLF line endings",
+			input:    "- - -\r\n",
+			line:     1,
+			expected: "- - -\r\n",
+		},
+		{
+			name:     "line out of range",
+			input:    "- item",
+			line:     3,
+			expected: "- item",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ToggleTaskMarker(tt.input, tt.line, cfg))
+		})
+	}
+}
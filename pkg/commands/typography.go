@@ -0,0 +1,168 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/notedownorg/notedown/pkg/config"
+)
+
+// asciiReplacer converts curly quotes and dashes to their ASCII equivalents
+var asciiReplacer = strings.NewReplacer(
+	"‘", "'", "’", "'",
+	"“", `"`, "”", `"`,
+	"—", "---", "–", "--",
+)
+
+var (
+	// protectedSpanRegex matches inline text that must be kept verbatim: wikilinks, link
+	// destinations, HTML comments and tags, and URLs
+	protectedSpanRegex = regexp.MustCompile(`^(?:\[\[.*?\]\]|\]\([^)]*\)|<!--.*?-->|<[A-Za-z/!][^<>]*>|[A-Za-z][A-Za-z0-9+.-]*://\S+)`)
+
+	// markupLineRegex matches thematic breaks, setext heading underlines and table delimiter rows
+	markupLineRegex = regexp.MustCompile(`^[\s\-=*_|:]*$`)
+)
+
+// NormalizeTypography converts curly quotes and em/en dashes to ASCII ("—" becomes "---" and
+// "–" becomes "--"), or the reverse when the typography style is "smart". Frontmatter, code
+// fences, inline code, wikilinks, link destinations, HTML and URLs are left untouched.
+func NormalizeTypography(content string, cfg *config.Config) string {
+	smart := cfg.Typography.StyleOrDefault() == config.TypographySmart
+	lines := strings.Split(content, "\n")
+
+	inFence := false
+	fenceMarker := ""
+	for i := frontmatterEnd(lines); i < len(lines); i++ {
+		if marker, ok := fenceDelimiter(lines[i]); ok {
+			if !inFence {
+				inFence, fenceMarker = true, marker
+			} else if marker == fenceMarker {
+				inFence = false
+			}
+			continue
+		}
+		if inFence || (smart && markupLineRegex.MatchString(lines[i])) {
+			continue
+		}
+		lines[i] = normalizeLineTypography(lines[i], smart)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// frontmatterEnd returns the index of the first line after a leading frontmatter block, or 0 if there is none
+func frontmatterEnd(lines []string) int {
+	if len(lines) == 0 || strings.TrimRight(lines[0], "\r") != "---" {
+		return 0
+	}
+	for i := 1; i < len(lines); i++ {
+		if delimiter := strings.TrimRight(lines[i], "\r"); delimiter == "---" || delimiter == "..." {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// normalizeLineTypography converts the prose of a single line, keeping task checkboxes and protected spans
+func normalizeLineTypography(line string, smart bool) string {
+	var b strings.Builder
+	textStart := 0
+	if match := taskLineRegex.FindStringIndex(line); match != nil {
+		b.WriteString(line[:match[1]])
+		textStart = match[1]
+	}
+
+	for i := textStart; i < len(line); {
+		end := protectedSpanEnd(line, i)
+		if end == i {
+			i++
+			continue
+		}
+		b.WriteString(convertTypography(line, textStart, i, smart))
+		b.WriteString(line[i:end])
+		i, textStart = end, end
+	}
+	b.WriteString(convertTypography(line, textStart, len(line), smart))
+
+	return b.String()
+}
+
+// protectedSpanEnd returns the end of a protected span starting at index i, or i if none starts there
+func protectedSpanEnd(line string, i int) int {
+	if line[i] == '`' {
+		// Inline code closes with a backtick run of the same length
+		n := len(line[i:]) - len(strings.TrimLeft(line[i:], "`"))
+		for j := i + n; j < len(line); {
+			k := strings.IndexByte(line[j:], '`')
+			if k == -1 {
+				break
+			}
+			k += j
+			m := len(line[k:]) - len(strings.TrimLeft(line[k:], "`"))
+			if m == n {
+				return k + m
+			}
+			j = k + m
+		}
+		return i + n // An unclosed run is literal backticks
+	}
+
+	if c := line[i]; c == '[' || c == ']' || c == '<' || (c < utf8.RuneSelf && unicode.IsLetter(rune(c))) {
+		if loc := protectedSpanRegex.FindStringIndex(line[i:]); loc != nil {
+			return i + loc[1]
+		}
+	}
+	return i
+}
+
+// convertTypography converts line[start:end], using the preceding text of the line to choose
+// between opening and closing quotes in the smart style
+func convertTypography(line string, start, end int, smart bool) string {
+	text := line[start:end]
+	if !smart {
+		return asciiReplacer.Replace(text)
+	}
+
+	text = strings.ReplaceAll(text, "---", "—")
+	text = strings.ReplaceAll(text, "--", "–")
+
+	var b strings.Builder
+	prev, _ := utf8.DecodeLastRuneInString(line[:start])
+	for _, r := range text {
+		switch {
+		case r == '\'' && opensQuote(prev):
+			b.WriteRune('‘')
+		case r == '\'':
+			b.WriteRune('’')
+		case r == '"' && opensQuote(prev):
+			b.WriteRune('“')
+		case r == '"':
+			b.WriteRune('”')
+		default:
+			b.WriteRune(r)
+		}
+		prev = r
+	}
+	return b.String()
+}
+
+// opensQuote checks if a quote following the given rune opens a quotation
+func opensQuote(prev rune) bool {
+	return prev == utf8.RuneError || unicode.IsSpace(prev) || strings.ContainsRune("([{<-—–/“‘", prev)
+}
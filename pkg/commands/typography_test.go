@@ -0,0 +1,97 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"testing"
+
+	"github.com/notedownorg/notedown/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTypography(t *testing.T) {
+	tests := []struct {
+		name     string
+		style    string
+		input    string
+		expected string
+	}{
+		{
+			name:     "ascii prose",
+			input:    "“Quoted,” she said — it’s fine – mostly.",
+			expected: `"Quoted," she said --- it's fine -- mostly.`,
+		},
+		{
+			name:     "ascii leaves fenced code untouched",
+			input:    "“prose”\n\n```go\ns := “code” — kept\n```\n~~~\n‘kept’\n~~~\n‘prose’",
+			expected: "\"prose\"\n\n```go\ns := “code” — kept\n```\n~~~\n‘kept’\n~~~\n'prose'",
+		},
+		{
+			name:     "ascii leaves inline code untouched",
+			input:    "Use `“x”` and ``a ` “b”`` — “done”",
+			expected: "Use `“x”` and ``a ` “b”`` --- \"done\"",
+		},
+		{
+			name:     "ascii keeps wikilinks, links and frontmatter",
+			input:    "---\ntitle: “Imported”\n---\nSee [[Bob’s notes]] and [“site”](https://example.com/a—b)",
+			expected: "---\ntitle: “Imported”\n---\nSee [[Bob’s notes]] and [\"site\"](https://example.com/a—b)",
+		},
+		{
+			name:     "ascii prose in task text",
+			input:    "- [x] Review “draft” — today",
+			expected: "- [x] Review \"draft\" --- today",
+		},
+		{
+			name:     "smart prose",
+			style:    config.TypographySmart,
+			input:    `"Quoted," she said --- it's fine -- 'mostly'.`,
+			expected: "“Quoted,” she said — it’s fine – ‘mostly’.",
+		},
+		{
+			name:     "smart leaves code and markup untouched",
+			style:    config.TypographySmart,
+			input:    "# Title\n\n---\n\n| a | b |\n|---|---|\n\nRun `--force \"now\"` or see <!-- don't -->\n\n```\necho \"x\" -- y\n```",
+			expected: "# Title\n\n---\n\n| a | b |\n|---|---|\n\nRun `--force \"now\"` or see <!-- don't -->\n\n```\necho \"x\" -- y\n```",
+		},
+		{
+			name:     "smart keeps wikilinks and URLs",
+			style:    config.TypographySmart,
+			input:    "[[it's-a-note]]'s link to https://example.com/a--b \"here\"",
+			expected: "[[it's-a-note]]’s link to https://example.com/a--b “here”",
+		},
+		{
+			name:     "no typography leaves content unchanged",
+			input:    "Plain 'ascii' text -- already",
+			expected: "Plain 'ascii' text -- already",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.GetDefaultConfig()
+			cfg.Typography.Style = tt.style
+			assert.Equal(t, tt.expected, NormalizeTypography(tt.input, cfg))
+		})
+	}
+}
+
+func TestApplyNormalizeTypography(t *testing.T) {
+	assert.True(t, IsKnown(NormalizeTypographyCommand))
+
+	result, err := Apply(NormalizeTypographyCommand, "“a” — `“b”`", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "\"a\" --- `“b”`", result)
+}
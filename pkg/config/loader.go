@@ -64,6 +64,12 @@ func LoadConfigFromFile(configPath string) (*Config, error) {
 		return nil, fmt.Errorf("unsupported config file format: %s (expected .yaml, .yml, or .json)", ext)
 	}
 
+	// Fall back to the default task states when the file leaves them out, e.g. a file
+	// that only configures the workspace. An explicitly empty list is still invalid.
+	if config.Tasks.States == nil {
+		config.Tasks.States = GetDefaultConfig().Tasks.States
+	}
+
 	// Validate the loaded configuration
 	if err := config.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid configuration in %s: %w", configPath, err)
@@ -25,6 +25,7 @@ import (
 
 func TestLoadConfigFromFile(t *testing.T) {
 	tempDir := t.TempDir()
+	check := "✓"
 
 	tests := []struct {
 		name          string
@@ -72,6 +73,75 @@ func TestLoadConfigFromFile(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "yaml config with conceal glyphs",
+			filename: "settings.yaml",
+			content: `tasks:
+  states:
+    - value: " "
+      name: "todo"
+    - value: "x"
+      name: "done"
+      aliases: ["completed"]
+      conceal: "✓"`,
+			expectedCfg: &Config{
+				Tasks: TasksConfig{
+					States: []TaskState{
+						{Value: " ", Name: "todo"},
+						{Value: "x", Name: "done", Aliases: []string{"completed"}, Conceal: &check},
+					},
+				},
+			},
+		},
+		{
+			name:     "yaml config with diagnostics severities",
+			filename: "settings.yaml",
+			content: `tasks:
+  states:
+    - value: " "
+      name: "todo"
+diagnostics:
+  severity:
+    unresolved_wikilink: hint
+    malformed_task_field: "off"`,
+			expectedCfg: &Config{
+				Tasks: TasksConfig{
+					States: []TaskState{
+						{Value: " ", Name: "todo"},
+					},
+				},
+				Diagnostics: DiagnosticsConfig{
+					Severity: map[string]string{
+						"unresolved_wikilink":  "hint",
+						"malformed_task_field": "off",
+					},
+				},
+			},
+		},
+		{
+			name:     "workspace only yaml uses default task states",
+			filename: "settings.yaml",
+			content: `workspace:
+  ignore:
+    - "archive/"`,
+			expectedCfg: &Config{
+				Tasks: GetDefaultConfig().Tasks,
+				Workspace: WorkspaceConfig{
+					Ignore: []string{"archive/"},
+				},
+			},
+		},
+		{
+			name:     "tasks without states uses default task states",
+			filename: "settings.json",
+			content:  `{"tasks": {}, "workspace": {"extensions": [".md", ".markdown"]}}`,
+			expectedCfg: &Config{
+				Tasks: GetDefaultConfig().Tasks,
+				Workspace: WorkspaceConfig{
+					Extensions: []string{".md", ".markdown"},
+				},
+			},
+		},
 		{
 			name:          "invalid yaml",
 			filename:      "settings.yaml",
@@ -25,6 +25,7 @@ type TaskState struct {
 	Name        string   `yaml:"name" json:"name"`
 	Description *string  `yaml:"description,omitempty" json:"description,omitempty"`
 	Aliases     []string `yaml:"aliases,omitempty" json:"aliases,omitempty"`
+	Conceal     *string  `yaml:"conceal,omitempty" json:"conceal,omitempty"`
 }
 
 // TasksConfig holds the configuration for task states
@@ -32,9 +33,97 @@ type TasksConfig struct {
 	States []TaskState `yaml:"states" json:"states"`
 }
 
+// DefaultMarkdownExtensions are the file extensions discovered when none are configured
+var DefaultMarkdownExtensions = []string{".md"}
+
+// WorkspaceConfig holds the configuration for workspace file discovery
+type WorkspaceConfig struct {
+	// Ignore lists gitignore-style patterns, relative to the workspace root, excluded from discovery
+	Ignore []string `yaml:"ignore,omitempty" json:"ignore,omitempty"`
+	// Extensions lists the file extensions treated as notes, defaults to DefaultMarkdownExtensions
+	Extensions []string `yaml:"extensions,omitempty" json:"extensions,omitempty"`
+}
+
+// DiagnosticSeverity is the severity reported for a diagnostic category, numbered as in the LSP
+// specification with an additional SeverityOff that suppresses the category
+type DiagnosticSeverity int
+
+const (
+	SeverityOff DiagnosticSeverity = iota
+	SeverityError
+	SeverityWarning
+	SeverityInformation
+	SeverityHint
+)
+
+// Diagnostic categories that can be configured under diagnostics.severity
+const (
+	DiagnosticUnresolvedWikilink = "unresolved_wikilink"
+	DiagnosticAmbiguousWikilink  = "ambiguous_wikilink"
+	DiagnosticMalformedTaskField = "malformed_task_field"
+)
+
+// severityNames maps configuration values to diagnostic severities
+var severityNames = map[string]DiagnosticSeverity{
+	"off":         SeverityOff,
+	"error":       SeverityError,
+	"warning":     SeverityWarning,
+	"information": SeverityInformation,
+	"hint":        SeverityHint,
+}
+
+// DiagnosticsConfig holds the configuration for diagnostics reporting
+type DiagnosticsConfig struct {
+	// Severity maps diagnostic categories to "error", "warning", "information", "hint" or "off"
+	Severity map[string]string `yaml:"severity,omitempty" json:"severity,omitempty"`
+}
+
+// Typography styles applied by typography normalization
+const (
+	TypographyASCII = "ascii"
+	TypographySmart = "smart"
+)
+
+// TypographyConfig holds the configuration for typography normalization
+type TypographyConfig struct {
+	// Style is "ascii" to replace curly quotes and dashes with ASCII, or "smart" for the reverse
+	Style string `yaml:"style,omitempty" json:"style,omitempty"`
+}
+
+// DefaultListIndent is the number of spaces nested list items are indented by when none is configured
+const DefaultListIndent = 2
+
+// ListsConfig holds the configuration for list tidying
+type ListsConfig struct {
+	// Indent is the number of spaces each level of nested list items is indented by
+	Indent int `yaml:"indent,omitempty" json:"indent,omitempty"`
+}
+
+// FrontmatterField describes a field of the canonical frontmatter schema
+type FrontmatterField struct {
+	Name string `yaml:"name" json:"name"`
+	// Required fields are added to frontmatter that lacks them, with Default or an empty value
+	Required bool `yaml:"required,omitempty" json:"required,omitempty"`
+	// Default is the value added when the field is missing
+	Default any `yaml:"default,omitempty" json:"default,omitempty"`
+}
+
+// FrontmatterConfig holds the canonical frontmatter schema used by frontmatter normalization
+type FrontmatterConfig struct {
+	// Fields lists the known fields in their canonical order, unknown fields follow them
+	Fields []FrontmatterField `yaml:"fields,omitempty" json:"fields,omitempty"`
+	// Require adds a frontmatter block to notes that have none
+	Require bool `yaml:"require,omitempty" json:"require,omitempty"`
+}
+
 // Config represents the complete workspace configuration
 type Config struct {
-	Tasks TasksConfig `yaml:"tasks" json:"tasks"`
+	Tasks       TasksConfig       `yaml:"tasks" json:"tasks"`
+	Workspace   WorkspaceConfig   `yaml:"workspace,omitempty" json:"workspace,omitempty"`
+	Diagnostics DiagnosticsConfig `yaml:"diagnostics,omitempty" json:"diagnostics,omitempty"`
+	Typography  TypographyConfig  `yaml:"typography,omitempty" json:"typography,omitempty"`
+	Frontmatter FrontmatterConfig `yaml:"frontmatter,omitempty" json:"frontmatter,omitempty"`
+	Lists       ListsConfig       `yaml:"lists,omitempty" json:"lists,omitempty"`
 }
 
 // Validate checks the configuration for consistency and conflicts
@@ -42,9 +131,136 @@ func (c *Config) Validate() error {
 	if err := c.Tasks.Validate(); err != nil {
 		return fmt.Errorf("tasks configuration error: %w", err)
 	}
+	if err := c.Workspace.Validate(); err != nil {
+		return fmt.Errorf("workspace configuration error: %w", err)
+	}
+	if err := c.Diagnostics.Validate(); err != nil {
+		return fmt.Errorf("diagnostics configuration error: %w", err)
+	}
+	if err := c.Typography.Validate(); err != nil {
+		return fmt.Errorf("typography configuration error: %w", err)
+	}
+	if err := c.Frontmatter.Validate(); err != nil {
+		return fmt.Errorf("frontmatter configuration error: %w", err)
+	}
+	if err := c.Lists.Validate(); err != nil {
+		return fmt.Errorf("lists configuration error: %w", err)
+	}
+	return nil
+}
+
+// Validate checks the diagnostics configuration for unknown severities
+func (dc *DiagnosticsConfig) Validate() error {
+	for category, severity := range dc.Severity {
+		if strings.TrimSpace(category) == "" {
+			return fmt.Errorf("severity category cannot be empty")
+		}
+		if _, ok := severityNames[strings.ToLower(strings.TrimSpace(severity))]; !ok {
+			return fmt.Errorf("category %q: unknown severity %q (expected error, warning, information, hint or off)", category, severity)
+		}
+	}
 	return nil
 }
 
+// SeverityFor returns the configured severity for a diagnostic category, or the fallback when
+// the category is not configured. SeverityOff means diagnostics of the category are suppressed.
+func (dc *DiagnosticsConfig) SeverityFor(category string, fallback DiagnosticSeverity) DiagnosticSeverity {
+	value, ok := dc.Severity[category]
+	if !ok {
+		return fallback
+	}
+	if severity, ok := severityNames[strings.ToLower(strings.TrimSpace(value))]; ok {
+		return severity
+	}
+	return fallback
+}
+
+// Validate checks the frontmatter schema for empty and duplicate field names
+func (fc *FrontmatterConfig) Validate() error {
+	seen := make(map[string]bool)
+	for i, field := range fc.Fields {
+		if strings.TrimSpace(field.Name) == "" {
+			return fmt.Errorf("field %d: name cannot be empty", i)
+		}
+		if seen[field.Name] {
+			return fmt.Errorf("duplicate field %q", field.Name)
+		}
+		seen[field.Name] = true
+	}
+	return nil
+}
+
+// Validate checks the typography configuration for an unknown style
+func (tc *TypographyConfig) Validate() error {
+	if tc.Style == "" {
+		return nil
+	}
+	switch tc.StyleOrDefault() {
+	case TypographyASCII, TypographySmart:
+		return nil
+	default:
+		return fmt.Errorf("unknown style %q (expected ascii or smart)", tc.Style)
+	}
+}
+
+// StyleOrDefault returns the lowercased configured style, or TypographyASCII when none is set
+func (tc *TypographyConfig) StyleOrDefault() string {
+	style := strings.ToLower(strings.TrimSpace(tc.Style))
+	if style == "" {
+		return TypographyASCII
+	}
+	return style
+}
+
+// Validate checks the lists configuration for an indent outside 1 to 8 spaces
+func (lc *ListsConfig) Validate() error {
+	if lc.Indent < 0 || lc.Indent > 8 {
+		return fmt.Errorf("indent must be between 1 and 8 spaces, got %d", lc.Indent)
+	}
+	return nil
+}
+
+// IndentOrDefault returns the configured indent, or DefaultListIndent when none is set
+func (lc *ListsConfig) IndentOrDefault() int {
+	if lc.Indent == 0 {
+		return DefaultListIndent
+	}
+	return lc.Indent
+}
+
+// Validate checks the workspace configuration for invalid patterns
+func (wc *WorkspaceConfig) Validate() error {
+	for i, pattern := range wc.Ignore {
+		if strings.TrimSpace(pattern) == "" {
+			return fmt.Errorf("ignore pattern %d cannot be empty", i)
+		}
+	}
+	for i, ext := range wc.Extensions {
+		trimmed := strings.TrimPrefix(strings.TrimSpace(ext), ".")
+		if trimmed == "" {
+			return fmt.Errorf("extension %d cannot be empty", i)
+		}
+		if strings.ContainsAny(trimmed, `/\*?. `) {
+			return fmt.Errorf("extension %q must be a single file extension such as \".md\"", ext)
+		}
+	}
+	return nil
+}
+
+// MarkdownExtensions returns the configured note file extensions, lowercased with a leading dot,
+// or DefaultMarkdownExtensions when none are configured
+func (wc *WorkspaceConfig) MarkdownExtensions() []string {
+	if len(wc.Extensions) == 0 {
+		return append([]string(nil), DefaultMarkdownExtensions...)
+	}
+
+	extensions := make([]string, 0, len(wc.Extensions))
+	for _, ext := range wc.Extensions {
+		extensions = append(extensions, "."+strings.ToLower(strings.TrimPrefix(strings.TrimSpace(ext), ".")))
+	}
+	return extensions
+}
+
 // Validate checks the tasks configuration for conflicts and consistency
 func (tc *TasksConfig) Validate() error {
 	if len(tc.States) == 0 {
@@ -68,6 +284,11 @@ func (tc *TasksConfig) Validate() error {
 			return fmt.Errorf("state %q: value cannot contain ']' character", state.Name)
 		}
 
+		// Conceal glyphs are optional but cannot be blank when set
+		if state.Conceal != nil && strings.TrimSpace(*state.Conceal) == "" {
+			return fmt.Errorf("state %q: conceal cannot be empty", state.Name)
+		}
+
 		// Check if value conflicts with existing values or aliases
 		if existing, exists := valueMap[state.Value]; exists {
 			return fmt.Errorf("state %q: value %q conflicts with state %q", state.Name, state.Value, existing)
@@ -116,3 +337,22 @@ func (ts *TaskState) HasValue(value string) bool {
 
 	return false
 }
+
+// FindState returns the task state matching the given value or alias, or nil if none is configured
+func (tc *TasksConfig) FindState(value string) *TaskState {
+	for i := range tc.States {
+		if tc.States[i].HasValue(value) {
+			return &tc.States[i]
+		}
+	}
+	return nil
+}
+
+// ConcealFor returns the conceal glyph configured for the state matching the given value or alias
+func (tc *TasksConfig) ConcealFor(value string) (string, bool) {
+	state := tc.FindState(value)
+	if state == nil || state.Conceal == nil {
+		return "", false
+	}
+	return *state.Conceal, true
+}
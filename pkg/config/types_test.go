@@ -49,7 +49,75 @@ func TestTaskState_HasValue(t *testing.T) {
 	}
 }
 
+func TestTasksConfig_FindState(t *testing.T) {
+	tasks := TasksConfig{
+		States: []TaskState{
+			{Value: " ", Name: "todo"},
+			{Value: "x", Name: "done", Aliases: []string{"X", "completed"}},
+			{Value: "wip", Name: "in-progress", Aliases: []string{"working"}},
+		},
+	}
+
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{" ", "todo"},
+		{"x", "done"},
+		{"completed", "done"},
+		{"working", "in-progress"},
+		{"unknown", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			state := tasks.FindState(tt.value)
+			if tt.expected == "" {
+				assert.Nil(t, state)
+				return
+			}
+			if assert.NotNil(t, state) {
+				assert.Equal(t, tt.expected, state.Name)
+			}
+		})
+	}
+}
+
+func TestTasksConfig_ConcealFor(t *testing.T) {
+	check := "✓"
+	cancelled := "✗"
+	tasks := TasksConfig{
+		States: []TaskState{
+			{Value: " ", Name: "todo"},
+			{Value: "x", Name: "done", Aliases: []string{"X", "completed"}, Conceal: &check},
+			{Value: "-", Name: "cancelled", Aliases: []string{"skip"}, Conceal: &cancelled},
+		},
+	}
+
+	tests := []struct {
+		value    string
+		expected string
+		found    bool
+	}{
+		{"x", "✓", true},
+		{"completed", "✓", true},
+		{"skip", "✗", true},
+		{" ", "", false},       // state without conceal
+		{"unknown", "", false}, // not configured
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			glyph, found := tasks.ConcealFor(tt.value)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.expected, glyph)
+		})
+	}
+}
+
 func TestTasksConfig_Validate(t *testing.T) {
+	blank := " "
+
 	tests := []struct {
 		name        string
 		config      TasksConfig
@@ -84,6 +152,16 @@ func TestTasksConfig_Validate(t *testing.T) {
 			expectError: true,
 			errorMsg:    "value cannot be empty",
 		},
+		{
+			name: "blank conceal",
+			config: TasksConfig{
+				States: []TaskState{
+					{Value: "x", Name: "done", Conceal: &blank},
+				},
+			},
+			expectError: true,
+			errorMsg:    "conceal cannot be empty",
+		},
 		{
 			name: "empty name",
 			config: TasksConfig{
@@ -197,6 +275,7 @@ func TestConfig_Validate(t *testing.T) {
 		name        string
 		config      Config
 		expectError bool
+		errorMsg    string
 	}{
 		{
 			name: "valid config",
@@ -218,6 +297,179 @@ func TestConfig_Validate(t *testing.T) {
 				},
 			},
 			expectError: true,
+			errorMsg:    "tasks configuration error",
+		},
+		{
+			name: "valid workspace ignore patterns",
+			config: Config{
+				Tasks: TasksConfig{
+					States: []TaskState{{Value: " ", Name: "todo"}},
+				},
+				Workspace: WorkspaceConfig{
+					Ignore: []string{"archive/", "**/drafts/*.md", "!keep.md"},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "valid workspace extensions",
+			config: Config{
+				Tasks: TasksConfig{
+					States: []TaskState{{Value: " ", Name: "todo"}},
+				},
+				Workspace: WorkspaceConfig{
+					Extensions: []string{".md", "markdown", ".MDX"},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "empty workspace extension",
+			config: Config{
+				Tasks: TasksConfig{
+					States: []TaskState{{Value: " ", Name: "todo"}},
+				},
+				Workspace: WorkspaceConfig{
+					Extensions: []string{".md", "."},
+				},
+			},
+			expectError: true,
+			errorMsg:    "workspace configuration error: extension 1 cannot be empty",
+		},
+		{
+			name: "workspace extension with glob",
+			config: Config{
+				Tasks: TasksConfig{
+					States: []TaskState{{Value: " ", Name: "todo"}},
+				},
+				Workspace: WorkspaceConfig{
+					Extensions: []string{"*.md"},
+				},
+			},
+			expectError: true,
+			errorMsg:    "extension \"*.md\" must be a single file extension",
+		},
+		{
+			name: "valid diagnostics severities",
+			config: Config{
+				Tasks: TasksConfig{
+					States: []TaskState{{Value: " ", Name: "todo"}},
+				},
+				Diagnostics: DiagnosticsConfig{
+					Severity: map[string]string{
+						DiagnosticUnresolvedWikilink: "hint",
+						DiagnosticAmbiguousWikilink:  "Error",
+						DiagnosticMalformedTaskField: "off",
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "unknown diagnostics severity",
+			config: Config{
+				Tasks: TasksConfig{
+					States: []TaskState{{Value: " ", Name: "todo"}},
+				},
+				Diagnostics: DiagnosticsConfig{
+					Severity: map[string]string{DiagnosticUnresolvedWikilink: "critical"},
+				},
+			},
+			expectError: true,
+			errorMsg:    "diagnostics configuration error: category \"unresolved_wikilink\": unknown severity \"critical\"",
+		},
+		{
+			name: "empty workspace ignore pattern",
+			config: Config{
+				Tasks: TasksConfig{
+					States: []TaskState{{Value: " ", Name: "todo"}},
+				},
+				Workspace: WorkspaceConfig{
+					Ignore: []string{"archive/", " "},
+				},
+			},
+			expectError: true,
+			errorMsg:    "workspace configuration error: ignore pattern 1 cannot be empty",
+		},
+		{
+			name: "valid typography style",
+			config: Config{
+				Tasks: TasksConfig{
+					States: []TaskState{{Value: " ", Name: "todo"}},
+				},
+				Typography: TypographyConfig{Style: "Smart"},
+			},
+			expectError: false,
+		},
+		{
+			name: "unknown typography style",
+			config: Config{
+				Tasks: TasksConfig{
+					States: []TaskState{{Value: " ", Name: "todo"}},
+				},
+				Typography: TypographyConfig{Style: "fancy"},
+			},
+			expectError: true,
+			errorMsg:    "typography configuration error: unknown style \"fancy\"",
+		},
+		{
+			name: "valid list indent",
+			config: Config{
+				Tasks: TasksConfig{
+					States: []TaskState{{Value: " ", Name: "todo"}},
+				},
+				Lists: ListsConfig{Indent: 4},
+			},
+			expectError: false,
+		},
+		{
+			name: "list indent out of range",
+			config: Config{
+				Tasks: TasksConfig{
+					States: []TaskState{{Value: " ", Name: "todo"}},
+				},
+				Lists: ListsConfig{Indent: 12},
+			},
+			expectError: true,
+			errorMsg:    "lists configuration error: indent must be between 1 and 8 spaces, got 12",
+		},
+		{
+			name: "valid frontmatter schema",
+			config: Config{
+				Tasks: TasksConfig{
+					States: []TaskState{{Value: " ", Name: "todo"}},
+				},
+				Frontmatter: FrontmatterConfig{
+					Fields: []FrontmatterField{{Name: "title", Required: true}, {Name: "tags", Default: []any{}}},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "empty frontmatter field name",
+			config: Config{
+				Tasks: TasksConfig{
+					States: []TaskState{{Value: " ", Name: "todo"}},
+				},
+				Frontmatter: FrontmatterConfig{
+					Fields: []FrontmatterField{{Name: "title"}, {Name: " "}},
+				},
+			},
+			expectError: true,
+			errorMsg:    "frontmatter configuration error: field 1: name cannot be empty",
+		},
+		{
+			name: "duplicate frontmatter field",
+			config: Config{
+				Tasks: TasksConfig{
+					States: []TaskState{{Value: " ", Name: "todo"}},
+				},
+				Frontmatter: FrontmatterConfig{
+					Fields: []FrontmatterField{{Name: "title"}, {Name: "title"}},
+				},
+			},
+			expectError: true,
+			errorMsg:    "frontmatter configuration error: duplicate field \"title\"",
 		},
 	}
 
@@ -226,10 +478,75 @@ func TestConfig_Validate(t *testing.T) {
 			err := tt.config.Validate()
 			if tt.expectError {
 				require.Error(t, err)
-				assert.Contains(t, err.Error(), "tasks configuration error")
+				assert.Contains(t, err.Error(), tt.errorMsg)
 			} else {
 				require.NoError(t, err)
 			}
 		})
 	}
 }
+
+func TestWorkspaceConfig_MarkdownExtensions(t *testing.T) {
+	tests := []struct {
+		name       string
+		extensions []string
+		expected   []string
+	}{
+		{"defaults when unset", nil, []string{".md"}},
+		{"keeps configured extensions", []string{".md", ".mdx"}, []string{".md", ".mdx"}},
+		{"normalizes dots and case", []string{"markdown", " .MD "}, []string{".markdown", ".md"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wc := WorkspaceConfig{Extensions: tt.extensions}
+			assert.Equal(t, tt.expected, wc.MarkdownExtensions())
+		})
+	}
+
+	// Callers modifying the result must not affect the defaults
+	wc := WorkspaceConfig{}
+	wc.MarkdownExtensions()[0] = ".txt"
+	assert.Equal(t, []string{".md"}, DefaultMarkdownExtensions)
+}
+
+func TestDiagnosticsConfig_SeverityFor(t *testing.T) {
+	diagnostics := DiagnosticsConfig{
+		Severity: map[string]string{
+			DiagnosticUnresolvedWikilink: "hint",
+			DiagnosticAmbiguousWikilink:  " Error ",
+			DiagnosticMalformedTaskField: "off",
+		},
+	}
+
+	tests := []struct {
+		category string
+		fallback DiagnosticSeverity
+		expected DiagnosticSeverity
+	}{
+		{DiagnosticUnresolvedWikilink, SeverityWarning, SeverityHint},
+		{DiagnosticAmbiguousWikilink, SeverityWarning, SeverityError},
+		{DiagnosticMalformedTaskField, SeverityWarning, SeverityOff},
+		{"unconfigured_category", SeverityInformation, SeverityInformation}, // keeps the default
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.category, func(t *testing.T) {
+			assert.Equal(t, tt.expected, diagnostics.SeverityFor(tt.category, tt.fallback))
+		})
+	}
+
+	// An empty configuration keeps every default
+	empty := DiagnosticsConfig{}
+	assert.Equal(t, SeverityWarning, empty.SeverityFor(DiagnosticUnresolvedWikilink, SeverityWarning))
+}
+
+func TestTypographyConfig_StyleOrDefault(t *testing.T) {
+	assert.Equal(t, TypographyASCII, (&TypographyConfig{}).StyleOrDefault())
+	assert.Equal(t, TypographySmart, (&TypographyConfig{Style: " SMART "}).StyleOrDefault())
+}
+
+func TestListsConfig_IndentOrDefault(t *testing.T) {
+	assert.Equal(t, DefaultListIndent, (&ListsConfig{}).IndentOrDefault())
+	assert.Equal(t, 4, (&ListsConfig{Indent: 4}).IndentOrDefault())
+}
@@ -0,0 +1,240 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extensions
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// calloutMarkerRegex matches a "[!TYPE]" callout marker with an optional fold marker and title
+var calloutMarkerRegex = regexp.MustCompile(`^\[!([A-Za-z0-9_-]+)\]([+-]?)(?:[ \t]+(.*?))?[ \t]*$`)
+
+// CalloutExtension turns blockquotes whose first line is a "[!TYPE]" marker into callouts:
+//
+//	> [!NOTE]- Optional title
+//	> Callout body
+type CalloutExtension struct{}
+
+// Extend implements goldmark.Extender
+func (e *CalloutExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithASTTransformers(
+			util.Prioritized(&calloutTransformer{}, 100),
+		),
+	)
+}
+
+// NewCalloutExtension creates a new callout extension
+func NewCalloutExtension() goldmark.Extender {
+	return &CalloutExtension{}
+}
+
+// calloutTransformer replaces callout blockquotes with CalloutAST nodes
+type calloutTransformer struct{}
+
+// Transform implements parser.ASTTransformer
+func (t *calloutTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+
+	// Collect first so the tree is not modified while walking it
+	var blockquotes []*ast.Blockquote
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if blockquote, ok := node.(*ast.Blockquote); ok && entering {
+			blockquotes = append(blockquotes, blockquote)
+		}
+		return ast.WalkContinue, nil
+	})
+
+	for _, blockquote := range blockquotes {
+		if callout := newCallout(blockquote, source); callout != nil {
+			blockquote.Parent().ReplaceChild(blockquote.Parent(), blockquote, callout)
+		}
+	}
+}
+
+// newCallout builds a callout from a blockquote, returning nil if the blockquote has no callout marker
+func newCallout(blockquote *ast.Blockquote, source []byte) *CalloutAST {
+	paragraph, ok := blockquote.FirstChild().(*ast.Paragraph)
+	if !ok || paragraph.Lines().Len() == 0 {
+		return nil
+	}
+
+	lines := paragraph.Lines()
+	firstLine := lines.At(0)
+	marker := util.TrimRightSpace(firstLine.Value(source))
+	match := calloutMarkerRegex.FindSubmatchIndex(marker)
+	if match == nil {
+		return nil
+	}
+
+	callout := &CalloutAST{
+		CalloutType: strings.ToLower(string(marker[match[2]:match[3]])),
+		Foldable:    match[5] > match[4],
+		Collapsed:   match[5] > match[4] && marker[match[4]] == '-',
+		segment:     text.NewSegment(quoteMarkerStart(firstLine.Start, source), firstLine.Stop),
+	}
+
+	titleStart := firstLine.Stop
+	var title *CalloutTitleAST
+	if match[6] >= 0 {
+		callout.Title = string(marker[match[6]:match[7]])
+		titleStart = firstLine.Start + match[6]
+		title = &CalloutTitleAST{}
+		title.Lines().Append(text.NewSegment(titleStart, firstLine.Start+match[7]))
+		callout.AppendChild(callout, title)
+	}
+
+	// Move the inline nodes of the title out of the marker line, dropping the marker text itself
+	for child := paragraph.FirstChild(); child != nil; child = paragraph.FirstChild() {
+		start, ok := inlineStart(child)
+		if !ok || start >= firstLine.Stop {
+			break
+		}
+		paragraph.RemoveChild(paragraph, child)
+
+		if textNode, ok := child.(*ast.Text); ok {
+			if textNode.Segment.Stop <= titleStart {
+				continue
+			}
+			if textNode.Segment.Start < titleStart {
+				textNode.Segment = textNode.Segment.WithStart(titleStart)
+			}
+			textNode.SetSoftLineBreak(false)
+		} else if start < titleStart {
+			continue
+		}
+		if title != nil {
+			title.AppendChild(title, child)
+		}
+	}
+	if lines.Len() == 1 {
+		blockquote.RemoveChild(blockquote, paragraph)
+	} else {
+		remaining := text.NewSegments()
+		remaining.AppendAll(lines.Sliced(1, lines.Len()))
+		paragraph.SetLines(remaining)
+	}
+
+	for child := blockquote.FirstChild(); child != nil; child = blockquote.FirstChild() {
+		callout.AppendChild(callout, child)
+	}
+	if stop := lastLineStop(callout); stop > callout.segment.Stop {
+		callout.segment.Stop = stop
+	}
+	return callout
+}
+
+// quoteMarkerStart steps back from the start of quoted content to its innermost ">" marker
+func quoteMarkerStart(offset int, source []byte) int {
+	i := offset
+	for i > 0 && (source[i-1] == ' ' || source[i-1] == '\t') {
+		i--
+	}
+	if i > 0 && source[i-1] == '>' {
+		return i - 1
+	}
+	return offset
+}
+
+// inlineStart returns the source offset where an inline node begins
+func inlineStart(node ast.Node) (int, bool) {
+	if textNode, ok := node.(*ast.Text); ok {
+		return textNode.Segment.Start, true
+	}
+	if segmented, ok := node.(interface{ Segment() text.Segment }); ok {
+		return segmented.Segment().Start, true
+	}
+	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+		if start, ok := inlineStart(child); ok {
+			return start, true
+		}
+	}
+	return 0, false
+}
+
+// lastLineStop returns the end offset of the last line held by a block or its descendants
+func lastLineStop(node ast.Node) int {
+	for child := node.LastChild(); child != nil; child = child.PreviousSibling() {
+		if child.Type() != ast.TypeBlock {
+			continue
+		}
+		if stop := lastLineStop(child); stop > 0 {
+			return stop
+		}
+		if lines := child.Lines(); lines.Len() > 0 {
+			return lines.At(lines.Len() - 1).Stop
+		}
+		if segmented, ok := child.(interface{ Segment() text.Segment }); ok {
+			return segmented.Segment().Stop
+		}
+	}
+	return 0
+}
+
+// CalloutTitleAST holds the inline content of a callout title, such as wikilinks, as its children
+type CalloutTitleAST struct {
+	ast.BaseBlock
+}
+
+// Dump implements ast.Node
+func (n *CalloutTitleAST) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, nil, nil)
+}
+
+// Kind returns the node kind
+func (n *CalloutTitleAST) Kind() ast.NodeKind {
+	return CalloutTitleKind
+}
+
+// CalloutTitleKind is the kind for callout title nodes
+var CalloutTitleKind = ast.NewNodeKind("CalloutTitle")
+
+// CalloutAST represents an Obsidian-style callout in the goldmark AST, its title and body are held as children
+type CalloutAST struct {
+	ast.BaseBlock
+	CalloutType string       // Lowercased type from the marker (e.g., "note" in [!NOTE])
+	Title       string       // Optional title following the marker, its inline content is a CalloutTitleAST child
+	Foldable    bool         // Marker is followed by "+" or "-"
+	Collapsed   bool         // Marker is followed by "-"
+	segment     text.Segment // Position information from the quote marker to the end of the body
+}
+
+// Segment returns the text segment of this callout
+func (n *CalloutAST) Segment() text.Segment {
+	return n.segment
+}
+
+// Dump implements ast.Node
+func (n *CalloutAST) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{
+		"CalloutType": n.CalloutType,
+		"Title":       n.Title,
+	}, nil)
+}
+
+// Kind returns the node kind
+func (n *CalloutAST) Kind() ast.NodeKind {
+	return CalloutKind
+}
+
+// CalloutKind is the kind for callout nodes
+var CalloutKind = ast.NewNodeKind("Callout")
@@ -0,0 +1,220 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extensions
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+func TestCalloutParsing(t *testing.T) {
+	tests := []struct {
+		name          string
+		markdown      string
+		wantCallout   bool
+		wantType      string
+		wantTitle     string
+		wantFoldable  bool
+		wantCollapsed bool
+		wantBody      string
+	}{
+		{
+			name:        "note without title",
+			markdown:    "> [!NOTE]\n> Body text",
+			wantCallout: true,
+			wantType:    "note",
+			wantBody:    "Body text",
+		},
+		{
+			name:        "warning with title",
+			markdown:    "> [!WARNING] Read this first\n> Body text",
+			wantCallout: true,
+			wantType:    "warning",
+			wantTitle:   "Read this first",
+			wantBody:    "Body text",
+		},
+		{
+			name:          "collapsed",
+			markdown:      "> [!tip]- Hidden tip\n> Body text",
+			wantCallout:   true,
+			wantType:      "tip",
+			wantTitle:     "Hidden tip",
+			wantFoldable:  true,
+			wantCollapsed: true,
+			wantBody:      "Body text",
+		},
+		{
+			name:         "expanded",
+			markdown:     "> [!faq]+ Question\n> Answer",
+			wantCallout:  true,
+			wantType:     "faq",
+			wantTitle:    "Question",
+			wantFoldable: true,
+			wantBody:     "Answer",
+		},
+		{
+			name:        "body in separate paragraph",
+			markdown:    "> [!info] Title\n>\n> Body text",
+			wantCallout: true,
+			wantType:    "info",
+			wantTitle:   "Title",
+			wantBody:    "Body text",
+		},
+		{
+			name:        "marker only",
+			markdown:    "> [!todo]",
+			wantCallout: true,
+			wantType:    "todo",
+		},
+		{
+			name:     "plain blockquote",
+			markdown: "> Just a quote",
+		},
+		{
+			name:     "marker not on first line",
+			markdown: "> Quote\n> [!NOTE]",
+		},
+		{
+			name:     "invalid type",
+			markdown: "> [!not a type]\n> Body",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := []byte(tt.markdown)
+			md := goldmark.New(goldmark.WithExtensions(NewCalloutExtension()))
+			doc := md.Parser().Parse(text.NewReader(source))
+
+			callout, ok := doc.FirstChild().(*CalloutAST)
+			if !tt.wantCallout {
+				if ok {
+					t.Fatalf("Expected a blockquote, got callout %q", callout.CalloutType)
+				}
+				if doc.FirstChild().Kind() != ast.KindBlockquote {
+					t.Errorf("Expected blockquote to be kept, got %s", doc.FirstChild().Kind())
+				}
+				return
+			}
+			if !ok {
+				t.Fatalf("Expected a callout, got %s", doc.FirstChild().Kind())
+			}
+
+			if callout.CalloutType != tt.wantType {
+				t.Errorf("Expected type %q, got %q", tt.wantType, callout.CalloutType)
+			}
+			if callout.Title != tt.wantTitle {
+				t.Errorf("Expected title %q, got %q", tt.wantTitle, callout.Title)
+			}
+			if callout.Foldable != tt.wantFoldable {
+				t.Errorf("Expected foldable %v, got %v", tt.wantFoldable, callout.Foldable)
+			}
+			if callout.Collapsed != tt.wantCollapsed {
+				t.Errorf("Expected collapsed %v, got %v", tt.wantCollapsed, callout.Collapsed)
+			}
+
+			var title, body []byte
+			_ = ast.Walk(callout, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+				if textNode, ok := node.(*ast.Text); ok && entering {
+					if textNode.Parent().Kind() == CalloutTitleKind {
+						title = append(title, textNode.Segment.Value(source)...)
+					} else {
+						body = append(body, textNode.Segment.Value(source)...)
+					}
+				}
+				return ast.WalkContinue, nil
+			})
+			if string(title) != tt.wantTitle {
+				t.Errorf("Expected title content %q, got %q", tt.wantTitle, string(title))
+			}
+			if string(body) != tt.wantBody {
+				t.Errorf("Expected body %q, got %q", tt.wantBody, string(body))
+			}
+
+			segment := callout.Segment()
+			if segment.Start != 0 || segment.Stop != len(source) {
+				t.Errorf("Expected segment to span the callout [0, %d), got [%d, %d)", len(source), segment.Start, segment.Stop)
+			}
+		})
+	}
+}
+
+func TestCalloutTitleKeepsInlineContent(t *testing.T) {
+	source := []byte("> [!NOTE]- See [[design]] now\n> Body")
+	md := goldmark.New(goldmark.WithExtensions(NewCalloutExtension(), NewWikilinkExtension()))
+	doc := md.Parser().Parse(text.NewReader(source))
+
+	callout, ok := doc.FirstChild().(*CalloutAST)
+	if !ok {
+		t.Fatalf("Expected a callout, got %s", doc.FirstChild().Kind())
+	}
+	if callout.Title != "See [[design]] now" {
+		t.Errorf("Expected raw title %q, got %q", "See [[design]] now", callout.Title)
+	}
+
+	title, ok := callout.FirstChild().(*CalloutTitleAST)
+	if !ok {
+		t.Fatalf("Expected a title as the first child, got %s", callout.FirstChild().Kind())
+	}
+
+	var kinds []string
+	for child := title.FirstChild(); child != nil; child = child.NextSibling() {
+		kinds = append(kinds, child.Kind().String())
+	}
+	if len(kinds) != 3 || kinds[1] != WikilinkKind.String() {
+		t.Fatalf("Expected text, wikilink and text in the title, got %v", kinds)
+	}
+	if wikilink := title.FirstChild().NextSibling().(*WikilinkAST); wikilink.Target != "design" {
+		t.Errorf("Expected wikilink target %q, got %q", "design", wikilink.Target)
+	}
+	first := title.FirstChild().(*ast.Text)
+	if got := string(first.Segment.Value(source)); got != "See " {
+		t.Errorf("Expected marker text to be stripped from the title, got %q", got)
+	}
+}
+
+func TestNestedCallouts(t *testing.T) {
+	source := []byte("> [!NOTE] Outer\n> Outer body\n>\n> > [!WARNING] Inner\n> > Inner body\n\nAfter")
+	md := goldmark.New(goldmark.WithExtensions(NewCalloutExtension()))
+	doc := md.Parser().Parse(text.NewReader(source))
+
+	outer, ok := doc.FirstChild().(*CalloutAST)
+	if !ok {
+		t.Fatalf("Expected outer callout, got %s", doc.FirstChild().Kind())
+	}
+	if outer.CalloutType != "note" || outer.Title != "Outer" {
+		t.Errorf("Unexpected outer callout %q %q", outer.CalloutType, outer.Title)
+	}
+
+	inner, ok := outer.LastChild().(*CalloutAST)
+	if !ok {
+		t.Fatalf("Expected nested callout, got %s", outer.LastChild().Kind())
+	}
+	if inner.CalloutType != "warning" || inner.Title != "Inner" {
+		t.Errorf("Unexpected inner callout %q %q", inner.CalloutType, inner.Title)
+	}
+
+	innerSegment := inner.Segment()
+	if got := string(source[innerSegment.Start:innerSegment.Stop]); got != "> [!WARNING] Inner\n> > Inner body" {
+		t.Errorf("Unexpected inner segment %q", got)
+	}
+	outerSegment := outer.Segment()
+	if outerSegment.Stop != innerSegment.Stop {
+		t.Errorf("Expected outer callout to end with the inner callout at %d, got %d", innerSegment.Stop, outerSegment.Stop)
+	}
+}
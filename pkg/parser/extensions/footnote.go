@@ -0,0 +1,228 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extensions
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// FootnoteExtension adds support for footnote references ([^label]) and definitions ([^label]: text).
+// Unlike goldmark's footnote extension, references without a definition and definitions without a
+// reference are kept, and definitions stay where they appear in the document.
+type FootnoteExtension struct{}
+
+// Extend implements goldmark.Extender
+func (e *FootnoteExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(
+			util.Prioritized(&footnoteDefinitionParser{}, 999), // Before paragraphs (1000)
+		),
+		parser.WithInlineParsers(
+			util.Prioritized(&footnoteReferenceParser{}, 100), // Higher priority than link (200)
+		),
+	)
+}
+
+// NewFootnoteExtension creates a new footnote extension
+func NewFootnoteExtension() goldmark.Extender {
+	return &FootnoteExtension{}
+}
+
+// parseFootnoteLabel reads a "[^label]" prefix, returning the label and the index of the closing bracket
+func parseFootnoteLabel(line []byte) ([]byte, int, bool) {
+	if len(line) < 4 || line[0] != '[' || line[1] != '^' {
+		return nil, 0, false
+	}
+
+	closePos := bytes.IndexByte(line, ']')
+	if closePos <= 2 {
+		return nil, 0, false
+	}
+
+	label := line[2:closePos]
+	if bytes.ContainsAny(label, " \t\r\n[") {
+		return nil, 0, false
+	}
+	return label, closePos, true
+}
+
+// footnoteReferenceParser parses footnote reference syntax
+type footnoteReferenceParser struct{}
+
+// Trigger returns the trigger characters for footnote references
+func (p *footnoteReferenceParser) Trigger() []byte {
+	return []byte{'['}
+}
+
+// Parse parses a footnote reference
+func (p *footnoteReferenceParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, segment := block.PeekLine()
+
+	label, closePos, ok := parseFootnoteLabel(line)
+	if !ok {
+		return nil
+	}
+
+	// "[^text](url)" and "[^text][ref]" are ordinary links whose text starts with a caret
+	if next := closePos + 1; next < len(line) && (line[next] == '(' || line[next] == '[') {
+		return nil
+	}
+
+	node := &FootnoteReferenceAST{
+		Label:   string(label),
+		segment: text.NewSegment(segment.Start, segment.Start+closePos+1),
+	}
+	block.Advance(closePos + 1)
+
+	return node
+}
+
+// footnoteDefinitionParser parses footnote definition blocks
+type footnoteDefinitionParser struct{}
+
+// Trigger returns the trigger characters for footnote definitions
+func (b *footnoteDefinitionParser) Trigger() []byte {
+	return []byte{'['}
+}
+
+// Open starts a footnote definition when a line begins with "[^label]:"
+func (b *footnoteDefinitionParser) Open(parent ast.Node, reader text.Reader, pc parser.Context) (ast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 {
+		return nil, parser.NoChildren
+	}
+
+	label, closePos, ok := parseFootnoteLabel(line[pos:])
+	if !ok {
+		return nil, parser.NoChildren
+	}
+	next := pos + closePos + 1
+	if next >= len(line) || line[next] != ':' {
+		return nil, parser.NoChildren
+	}
+
+	padding := segment.Padding
+	start := segment.Start + pos - padding
+	node := &FootnoteDefinitionAST{
+		Label:   string(label),
+		segment: text.NewSegment(start, segment.Start+next+1-padding),
+	}
+
+	pos = next + 1 - padding
+	if pos >= len(line) {
+		reader.Advance(pos)
+		return node, parser.NoChildren
+	}
+	reader.AdvanceAndSetPadding(pos, padding)
+	return node, parser.HasChildren
+}
+
+// Continue keeps indented lines and blank lines within the definition
+func (b *footnoteDefinitionParser) Continue(node ast.Node, reader text.Reader, pc parser.Context) parser.State {
+	line, _ := reader.PeekLine()
+	if util.IsBlank(line) {
+		return parser.Continue | parser.HasChildren
+	}
+	childpos, padding := util.IndentPosition(line, reader.LineOffset(), 4)
+	if childpos < 0 {
+		return parser.Close
+	}
+	reader.AdvanceAndSetPadding(childpos, padding)
+	return parser.Continue | parser.HasChildren
+}
+
+// Close extends the definition segment over the content of its last child block
+func (b *footnoteDefinitionParser) Close(node ast.Node, reader text.Reader, pc parser.Context) {
+	definition := node.(*FootnoteDefinitionAST)
+	for child := node.LastChild(); child != nil; child = child.LastChild() {
+		if lines := child.Lines(); lines != nil && lines.Len() > 0 {
+			if stop := lines.At(lines.Len() - 1).Stop; stop > definition.segment.Stop {
+				definition.segment.Stop = stop
+			}
+			break
+		}
+	}
+}
+
+// CanInterruptParagraph implements parser.BlockParser
+func (b *footnoteDefinitionParser) CanInterruptParagraph() bool {
+	return true
+}
+
+// CanAcceptIndentedLine implements parser.BlockParser
+func (b *footnoteDefinitionParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+// FootnoteReferenceAST represents a footnote reference in the goldmark AST
+type FootnoteReferenceAST struct {
+	ast.BaseInline
+	Label   string       // Label between "[^" and "]" (e.g., "1" in [^1])
+	segment text.Segment // Position information
+}
+
+// Segment returns the text segment of this footnote reference
+func (n *FootnoteReferenceAST) Segment() text.Segment {
+	return n.segment
+}
+
+// Dump implements ast.Node
+func (n *FootnoteReferenceAST) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{
+		"Label": n.Label,
+	}, nil)
+}
+
+// Kind returns the node kind
+func (n *FootnoteReferenceAST) Kind() ast.NodeKind {
+	return FootnoteReferenceKind
+}
+
+// FootnoteReferenceKind is the kind for footnote reference nodes
+var FootnoteReferenceKind = ast.NewNodeKind("FootnoteReference")
+
+// FootnoteDefinitionAST represents a footnote definition block in the goldmark AST
+type FootnoteDefinitionAST struct {
+	ast.BaseBlock
+	Label   string       // Label between "[^" and "]:" (e.g., "1" in [^1]: text)
+	segment text.Segment // Position information from the opening bracket to the end of the content
+}
+
+// Segment returns the text segment of this footnote definition
+func (n *FootnoteDefinitionAST) Segment() text.Segment {
+	return n.segment
+}
+
+// Dump implements ast.Node
+func (n *FootnoteDefinitionAST) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{
+		"Label": n.Label,
+	}, nil)
+}
+
+// Kind returns the node kind
+func (n *FootnoteDefinitionAST) Kind() ast.NodeKind {
+	return FootnoteDefinitionKind
+}
+
+// FootnoteDefinitionKind is the kind for footnote definition nodes
+var FootnoteDefinitionKind = ast.NewNodeKind("FootnoteDefinition")
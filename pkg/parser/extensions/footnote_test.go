@@ -0,0 +1,148 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extensions
+
+import (
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+func TestFootnoteParsing(t *testing.T) {
+	tests := []struct {
+		name            string
+		markdown        string
+		wantReferences  []string
+		wantDefinitions []string
+	}{
+		{
+			name:            "reference and definition",
+			markdown:        "A claim.[^1]\n\n[^1]: The source.",
+			wantReferences:  []string{"1"},
+			wantDefinitions: []string{"1"},
+		},
+		{
+			name:            "named labels",
+			markdown:        "See [^note] and [^other-note].\n\n[^note]: First.\n[^other-note]: Second.",
+			wantReferences:  []string{"note", "other-note"},
+			wantDefinitions: []string{"note", "other-note"},
+		},
+		{
+			name:            "reference without definition",
+			markdown:        "Missing source.[^missing]",
+			wantReferences:  []string{"missing"},
+			wantDefinitions: []string{},
+		},
+		{
+			name:            "definition without reference",
+			markdown:        "No references here.\n\n[^unused]: Orphaned note.",
+			wantReferences:  []string{},
+			wantDefinitions: []string{"unused"},
+		},
+		{
+			name:            "not footnotes",
+			markdown:        "A [link](url), [^] empty, [^with space] and [text] brackets.",
+			wantReferences:  []string{},
+			wantDefinitions: []string{},
+		},
+		{
+			name:            "no footnotes in code",
+			markdown:        "`[^inline]`\n\n```\n[^fenced]: text\n```",
+			wantReferences:  []string{},
+			wantDefinitions: []string{},
+		},
+		{
+			name:            "wikilinks are unaffected",
+			markdown:        "[[page]] with a note[^1]\n\n[^1]: Text with [[other]].",
+			wantReferences:  []string{"1"},
+			wantDefinitions: []string{"1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := goldmark.New(goldmark.WithExtensions(NewFootnoteExtension(), NewWikilinkExtension()))
+			doc := md.Parser().Parse(text.NewReader([]byte(tt.markdown)))
+
+			references := []string{}
+			definitions := []string{}
+			_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+				if entering {
+					switch n := node.(type) {
+					case *FootnoteReferenceAST:
+						references = append(references, n.Label)
+					case *FootnoteDefinitionAST:
+						definitions = append(definitions, n.Label)
+					}
+				}
+				return ast.WalkContinue, nil
+			})
+
+			if len(references) != len(tt.wantReferences) {
+				t.Fatalf("Expected references %v, got %v", tt.wantReferences, references)
+			}
+			for i, label := range references {
+				if label != tt.wantReferences[i] {
+					t.Errorf("Reference %d: expected label %q, got %q", i, tt.wantReferences[i], label)
+				}
+			}
+
+			if len(definitions) != len(tt.wantDefinitions) {
+				t.Fatalf("Expected definitions %v, got %v", tt.wantDefinitions, definitions)
+			}
+			for i, label := range definitions {
+				if label != tt.wantDefinitions[i] {
+					t.Errorf("Definition %d: expected label %q, got %q", i, tt.wantDefinitions[i], label)
+				}
+			}
+		})
+	}
+}
+
+func TestFootnoteSegments(t *testing.T) {
+	source := []byte("Text[^a] here.\n\n[^a]: First line\n    continued.\n\nAfter.")
+	md := goldmark.New(goldmark.WithExtensions(NewFootnoteExtension()))
+	doc := md.Parser().Parse(text.NewReader(source))
+
+	var reference *FootnoteReferenceAST
+	var definition *FootnoteDefinitionAST
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if entering {
+			switch n := node.(type) {
+			case *FootnoteReferenceAST:
+				reference = n
+			case *FootnoteDefinitionAST:
+				definition = n
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+
+	if reference == nil || definition == nil {
+		t.Fatal("Expected a footnote reference and definition")
+	}
+
+	referenceSegment := reference.Segment()
+	if got := string(referenceSegment.Value(source)); got != "[^a]" {
+		t.Errorf("Expected reference segment %q, got %q", "[^a]", got)
+	}
+
+	segment := definition.Segment()
+	if got := string(source[segment.Start:segment.Stop]); got != "[^a]: First line\n    continued." {
+		t.Errorf("Expected definition segment to span its content, got %q", got)
+	}
+}
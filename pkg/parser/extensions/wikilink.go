@@ -26,7 +26,7 @@ import (
 	"github.com/yuin/goldmark/util"
 )
 
-// WikilinkExtension adds support for wikilinks ([[page]] and [[page|display]])
+// WikilinkExtension adds support for wikilinks ([[page]], [[page|display]] and [[page#heading]])
 type WikilinkExtension struct{}
 
 // Extend implements goldmark.Extender
@@ -105,7 +105,14 @@ func (p *wikilinkParser) Parse(parent ast.Node, block text.Reader, pc parser.Con
 		displayText = target
 	}
 
-	if target == "" {
+	// Split off a heading anchor ([[page#heading]]), an empty target refers to the current document
+	anchor := ""
+	if hashPos := strings.Index(target, "#"); hashPos != -1 {
+		anchor = strings.TrimSpace(target[hashPos+1:])
+		target = strings.TrimSpace(target[:hashPos])
+	}
+
+	if target == "" && anchor == "" {
 		return nil
 	}
 
@@ -122,6 +129,7 @@ func (p *wikilinkParser) Parse(parent ast.Node, block text.Reader, pc parser.Con
 
 	node := &WikilinkAST{
 		Target:       target,
+		Anchor:       anchor,
 		DisplayText:  displayText,
 		HasPipe:      hasPipe,
 		ConcealStart: concealStart,
@@ -139,6 +147,7 @@ func (p *wikilinkParser) Parse(parent ast.Node, block text.Reader, pc parser.Con
 type WikilinkAST struct {
 	ast.BaseInline
 	Target       string
+	Anchor       string // Heading anchor after # (e.g., "Goals" in [[design#Goals]])
 	DisplayText  string
 	HasPipe      bool         // Whether this wikilink has a pipe separator
 	ConcealStart int          // Start position of concealable range (relative to wikilink start)
@@ -155,6 +164,7 @@ func (n *WikilinkAST) Segment() text.Segment {
 func (n *WikilinkAST) Dump(source []byte, level int) {
 	ast.DumpHelper(n, source, level, map[string]string{
 		"Target":       n.Target,
+		"Anchor":       n.Anchor,
 		"DisplayText":  n.DisplayText,
 		"HasPipe":      fmt.Sprintf("%v", n.HasPipe),
 		"ConcealStart": fmt.Sprintf("%d", n.ConcealStart),
@@ -244,6 +244,94 @@ func TestWikilinkParser_Parse(t *testing.T) {
 	}
 }
 
+func TestWikilinkAnchors(t *testing.T) {
+	tests := []struct {
+		name        string
+		markdown    string
+		wantTarget  string
+		wantAnchor  string
+		wantDisplay string
+	}{
+		{
+			name:        "heading anchor",
+			markdown:    "See [[design#Goals]]",
+			wantTarget:  "design",
+			wantAnchor:  "Goals",
+			wantDisplay: "design#Goals",
+		},
+		{
+			name:        "heading anchor with display text",
+			markdown:    "See [[design#Goals|the goals]]",
+			wantTarget:  "design",
+			wantAnchor:  "Goals",
+			wantDisplay: "the goals",
+		},
+		{
+			name:        "path target with anchor containing spaces",
+			markdown:    "See [[docs/api#Error Handling]]",
+			wantTarget:  "docs/api",
+			wantAnchor:  "Error Handling",
+			wantDisplay: "docs/api#Error Handling",
+		},
+		{
+			name:        "same document anchor",
+			markdown:    "See [[#Summary]]",
+			wantTarget:  "",
+			wantAnchor:  "Summary",
+			wantDisplay: "#Summary",
+		},
+		{
+			name:        "empty anchor",
+			markdown:    "See [[design#]]",
+			wantTarget:  "design",
+			wantAnchor:  "",
+			wantDisplay: "design#",
+		},
+	}
+
+	md := goldmark.New(goldmark.WithExtensions(NewWikilinkExtension()))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := md.Parser().Parse(text.NewReader([]byte(tt.markdown)))
+
+			var wikilinks []*WikilinkAST
+			_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+				if entering {
+					if wl, ok := node.(*WikilinkAST); ok {
+						wikilinks = append(wikilinks, wl)
+					}
+				}
+				return ast.WalkContinue, nil
+			})
+
+			if len(wikilinks) != 1 {
+				t.Fatalf("Expected 1 wikilink, got %d", len(wikilinks))
+			}
+
+			wl := wikilinks[0]
+			if wl.Target != tt.wantTarget {
+				t.Errorf("Expected target %q, got %q", tt.wantTarget, wl.Target)
+			}
+			if wl.Anchor != tt.wantAnchor {
+				t.Errorf("Expected anchor %q, got %q", tt.wantAnchor, wl.Anchor)
+			}
+			if wl.DisplayText != tt.wantDisplay {
+				t.Errorf("Expected display %q, got %q", tt.wantDisplay, wl.DisplayText)
+			}
+		})
+	}
+
+	// A bare # is neither a target nor an anchor
+	doc := md.Parser().Parse(text.NewReader([]byte("[[#]]")))
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if _, ok := node.(*WikilinkAST); ok && entering {
+			t.Error("Expected [[#]] not to be parsed as a wikilink")
+		}
+		return ast.WalkContinue, nil
+	})
+}
+
 func TestWikilinkAST_Kind(t *testing.T) {
 	node := &WikilinkAST{
 		Target:      "test",
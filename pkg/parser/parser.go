@@ -16,6 +16,7 @@ package parser
 
 import (
 	"bytes"
+	"regexp"
 
 	"github.com/notedownorg/notedown/pkg/config"
 	"github.com/notedownorg/notedown/pkg/parser/extensions"
@@ -27,6 +28,9 @@ import (
 	"go.abhg.dev/goldmark/frontmatter"
 )
 
+// blockIDRegex matches a trailing "^id" block anchor at the end of a line
+var blockIDRegex = regexp.MustCompile(`(?:^|\s)\^([A-Za-z0-9_-]+)\s*$`)
+
 // Parser defines the interface for parsing markdown documents
 type Parser interface {
 	Parse(source []byte) (*Document, error)
@@ -49,7 +53,8 @@ func NewParser() Parser {
 				extension.Table,
 				extension.Strikethrough,
 				extension.Linkify,
-				extension.Footnote,
+				extensions.NewFootnoteExtension(),
+				extensions.NewCalloutExtension(),
 				extensions.NewWikilinkExtension(),
 				extensions.NewTaskListExtension(cfg),
 				&frontmatter.Extender{},
@@ -70,10 +75,10 @@ func (p *NotedownParser) Parse(source []byte) (*Document, error) {
 	// Convert AST to our tree structure
 	doc := p.convertAST(astDoc, source)
 
-	// Extract frontmatter if present
+	// Extract frontmatter if present, empty or invalid frontmatter leaves the metadata empty
 	if data := frontmatter.Get(context); data != nil {
 		var metadata map[string]any
-		if err := data.Decode(&metadata); err == nil {
+		if err := data.Decode(&metadata); err == nil && metadata != nil {
 			doc.Metadata = metadata
 		}
 	}
@@ -156,6 +161,14 @@ func (p *NotedownParser) astToTreeNode(astNode ast.Node, source []byte) Node {
 						}
 					}
 				}
+				// Footnote definitions and callouts record their own position as they have no lines
+				if segmented, ok := astNode.(interface{ Segment() text.Segment }); ok {
+					segment := segmented.Segment()
+					rng = Range{
+						Start: p.offsetToPosition(segment.Start, source),
+						End:   p.offsetToPosition(segment.Stop, source),
+					}
+				}
 				if rng.Start.Line == 0 { // fallback if position not found
 					rng = Range{
 						Start: Position{Line: 1, Column: 1, Offset: 0},
@@ -196,7 +209,25 @@ func (p *NotedownParser) astToTreeNode(astNode ast.Node, source []byte) Node {
 				End:   concealEnd,
 			}
 		}
-		return NewWikilinkWithConceal(wikilink.Target, wikilink.DisplayText, rng, wikilink.HasPipe, concealRange)
+		node := NewWikilinkWithConceal(wikilink.Target, wikilink.DisplayText, rng, wikilink.HasPipe, concealRange)
+		node.Anchor = wikilink.Anchor
+		return node
+	}
+
+	// Handle footnote nodes
+	if reference, ok := astNode.(*extensions.FootnoteReferenceAST); ok {
+		return NewFootnoteReference(reference.Label, rng)
+	}
+	if definition, ok := astNode.(*extensions.FootnoteDefinitionAST); ok {
+		return NewFootnoteDefinition(definition.Label, rng)
+	}
+
+	// Handle callout nodes
+	if callout, ok := astNode.(*extensions.CalloutAST); ok {
+		return NewCallout(callout.CalloutType, callout.Title, callout.Foldable, callout.Collapsed, rng)
+	}
+	if _, ok := astNode.(*extensions.CalloutTitleAST); ok {
+		return NewCalloutTitle(rng)
 	}
 
 	// Debug: Check for heading first
@@ -280,7 +311,9 @@ func (p *NotedownParser) astToTreeNode(astNode ast.Node, source []byte) Node {
 			}
 		}
 
-		return NewListItem(taskList, taskState, rng)
+		listItem := NewListItem(taskList, taskState, rng)
+		listItem.BlockID = listItemBlockID(n, source)
+		return listItem
 
 	case *ast.Emphasis:
 		return NewEmphasis(rng)
@@ -313,6 +346,24 @@ func (p *NotedownParser) astToTreeNode(astNode ast.Node, source []byte) Node {
 	}
 }
 
+// listItemBlockID extracts the "^id" block anchor from the first line of a list item
+func listItemBlockID(item *ast.ListItem, source []byte) string {
+	first := item.FirstChild()
+	if first == nil {
+		return ""
+	}
+	segmentable, ok := first.(interface{ Lines() *text.Segments })
+	if !ok || segmentable.Lines().Len() == 0 {
+		return ""
+	}
+
+	firstLine := segmentable.Lines().At(0)
+	if match := blockIDRegex.FindSubmatch(firstLine.Value(source)); match != nil {
+		return string(match[1])
+	}
+	return ""
+}
+
 // offsetToPosition converts byte offset to line/column position
 func (p *NotedownParser) offsetToPosition(offset int, source []byte) Position {
 	if offset > len(source) {
@@ -0,0 +1,130 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCallouts(t *testing.T) {
+	source := `# Notes
+
+> [!NOTE] Remember
+> Callouts keep their body as children.
+
+> [!warning]- Collapsed by default
+> - [ ] Task inside a callout
+> - See [[other-page]]
+>
+> > [!TIP]
+> > Nested callout
+
+> A plain quote
+`
+
+	parser := NewParser()
+	doc, err := parser.ParseString(source)
+	require.NoError(t, err)
+
+	var callouts []*Callout
+	walker := NewWalker(WalkFunc(func(node Node) error {
+		if callout, ok := node.(*Callout); ok {
+			callouts = append(callouts, callout)
+		}
+		return nil
+	}))
+	require.NoError(t, walker.Walk(doc))
+	require.Len(t, callouts, 3)
+
+	t.Run("titled callout", func(t *testing.T) {
+		note := callouts[0]
+		assert.Equal(t, NodeCallout, note.Type())
+		assert.Equal(t, "note", note.CalloutType)
+		assert.Equal(t, "Remember", note.Title)
+		assert.False(t, note.Foldable)
+		assert.Equal(t, Position{Line: 3, Column: 1, Offset: 9}, note.Range().Start)
+		assert.Equal(t, 4, note.Range().End.Line)
+		require.Len(t, note.Children(), 2)
+		assert.Equal(t, NodeCalloutTitle, note.Children()[0].Type())
+		assert.Equal(t, NodeParagraph, note.Children()[1].Type())
+	})
+
+	t.Run("collapsed callout with content", func(t *testing.T) {
+		warning := callouts[1]
+		assert.Equal(t, "warning", warning.CalloutType)
+		assert.Equal(t, "Collapsed by default", warning.Title)
+		assert.True(t, warning.Foldable)
+		assert.True(t, warning.Collapsed)
+		assert.Equal(t, 6, warning.Range().Start.Line)
+		assert.Equal(t, 11, warning.Range().End.Line)
+
+		task := doc.FindListItemAtLine(7)
+		require.NotNil(t, task, "task inside the callout is still parsed")
+		assert.True(t, task.TaskList)
+
+		var wikilinks []*Wikilink
+		require.NoError(t, NewWalker(WalkFunc(func(node Node) error {
+			if wikilink, ok := node.(*Wikilink); ok {
+				wikilinks = append(wikilinks, wikilink)
+			}
+			return nil
+		})).Walk(warning))
+		require.Len(t, wikilinks, 1)
+		assert.Equal(t, "other-page", wikilinks[0].Target)
+	})
+
+	t.Run("untitled callout has no title child", func(t *testing.T) {
+		require.NotEmpty(t, callouts[2].Children())
+		assert.Equal(t, NodeParagraph, callouts[2].Children()[0].Type())
+	})
+
+	t.Run("nested callout", func(t *testing.T) {
+		tip := callouts[2]
+		assert.Equal(t, "tip", tip.CalloutType)
+		assert.Empty(t, tip.Title)
+		assert.Equal(t, callouts[1].BaseNode, tip.Parent())
+		assert.Equal(t, Position{Line: 10, Column: 3, Offset: tip.Range().Start.Offset}, tip.Range().Start)
+	})
+}
+
+func TestParseCalloutTitleWikilinks(t *testing.T) {
+	parser := NewParser()
+	doc, err := parser.ParseString("> [!NOTE] See [[design]] now\n> Body with [[other]]\n")
+	require.NoError(t, err)
+
+	require.Len(t, doc.Children(), 1)
+	callout, ok := doc.Children()[0].(*Callout)
+	require.True(t, ok, "expected a callout, got %s", doc.Children()[0].Type())
+	assert.Equal(t, "See [[design]] now", callout.Title)
+
+	title, ok := callout.Children()[0].(*CalloutTitle)
+	require.True(t, ok, "expected a callout title as the first child")
+	assert.Equal(t, Position{Line: 1, Column: 11, Offset: 10}, title.Range().Start)
+
+	var targets []string
+	require.NoError(t, NewWalker(WalkFunc(func(node Node) error {
+		if wikilink, ok := node.(*Wikilink); ok {
+			targets = append(targets, wikilink.Target)
+			if wikilink.Target == "design" {
+				assert.Equal(t, Position{Line: 1, Column: 15, Offset: 14}, wikilink.Range().Start)
+			}
+		}
+		return nil
+	})).Walk(doc))
+	assert.Equal(t, []string{"design", "other"}, targets)
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFootnotes(t *testing.T) {
+	source := `# Research
+
+Cited claim.[^1] Another one.[^Source]
+
+Unresolved reference.[^missing]
+
+[^1]: First source.
+[^source]: Second source, matched case-insensitively.
+    With a continuation line.
+[^unused]: Never referenced.
+`
+
+	parser := NewParser()
+	doc, err := parser.ParseString(source)
+	require.NoError(t, err)
+
+	t.Run("references keep their position", func(t *testing.T) {
+		var references []*FootnoteReference
+		walker := NewWalker(WalkFunc(func(node Node) error {
+			if reference, ok := node.(*FootnoteReference); ok {
+				references = append(references, reference)
+			}
+			return nil
+		}))
+		require.NoError(t, walker.Walk(doc))
+
+		require.Len(t, references, 3)
+		assert.Equal(t, "1", references[0].Label)
+		assert.Equal(t, Position{Line: 3, Column: 13, Offset: 24}, references[0].Range().Start)
+		assert.Equal(t, 17, references[0].Range().End.Column)
+		assert.Equal(t, "Source", references[1].Label)
+		assert.Equal(t, "missing", references[2].Label)
+		assert.Equal(t, 5, references[2].Range().Start.Line)
+	})
+
+	t.Run("definitions resolve from references", func(t *testing.T) {
+		definition := doc.FindFootnoteDefinition("1")
+		require.NotNil(t, definition)
+		assert.Equal(t, 7, definition.Range().Start.Line)
+		assert.Equal(t, 1, definition.Range().Start.Column)
+
+		definition = doc.FindFootnoteDefinition("Source")
+		require.NotNil(t, definition)
+		assert.Equal(t, "source", definition.Label)
+		assert.Equal(t, 8, definition.Range().Start.Line)
+		assert.Equal(t, 9, definition.Range().End.Line)
+		assert.NotEmpty(t, definition.Children(), "definition content is kept as children")
+
+		assert.Nil(t, doc.FindFootnoteDefinition("missing"))
+	})
+
+	t.Run("undefined references", func(t *testing.T) {
+		undefined := doc.UndefinedFootnoteReferences()
+		require.Len(t, undefined, 1)
+		assert.Equal(t, "missing", undefined[0].Label)
+	})
+
+	t.Run("unused definitions", func(t *testing.T) {
+		unused := doc.UnusedFootnoteDefinitions()
+		require.Len(t, unused, 1)
+		assert.Equal(t, "unused", unused[0].Label)
+		assert.Equal(t, 10, unused[0].Range().Start.Line)
+	})
+}
+
+func TestParseFootnotesLeaveCaretLinks(t *testing.T) {
+	source := `See [^caret](http://x) here and [^ref][target] there.
+
+[target]: http://example.com
+`
+
+	parser := NewParser()
+	doc, err := parser.ParseString(source)
+	require.NoError(t, err)
+
+	counts := make(map[NodeType]int)
+	walker := NewWalker(WalkFunc(func(node Node) error {
+		counts[node.Type()]++
+		return nil
+	}))
+	require.NoError(t, walker.Walk(doc))
+
+	assert.Equal(t, 0, counts[NodeFootnoteReference])
+	assert.Equal(t, 2, counts[NodeLink])
+}
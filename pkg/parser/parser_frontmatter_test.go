@@ -0,0 +1,126 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFrontmatter(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		expected map[string]any
+	}{
+		{
+			name: "scalars",
+			markdown: `---
+title: Project Notes
+priority: 3
+rating: 4.5
+draft: false
+---
+# Project Notes`,
+			expected: map[string]any{
+				"title":    "Project Notes",
+				"priority": 3,
+				"rating":   4.5,
+				"draft":    false,
+			},
+		},
+		{
+			name: "lists",
+			markdown: `---
+tags: [project, planning]
+authors:
+  - alice
+  - bob
+---
+Content`,
+			expected: map[string]any{
+				"tags":    []any{"project", "planning"},
+				"authors": []any{"alice", "bob"},
+			},
+		},
+		{
+			name: "nested maps",
+			markdown: `---
+project:
+  name: alpha
+  status: active
+  owners:
+    lead: alice
+---
+Content`,
+			expected: map[string]any{
+				"project": map[string]any{
+					"name":   "alpha",
+					"status": "active",
+					"owners": map[string]any{"lead": "alice"},
+				},
+			},
+		},
+		{
+			name:     "windows line endings",
+			markdown: "---\r\ntitle: Windows\r\ntags: [a, b]\r\n---\r\n# Heading\r\n",
+			expected: map[string]any{
+				"title": "Windows",
+				"tags":  []any{"a", "b"},
+			},
+		},
+		{
+			name:     "empty frontmatter",
+			markdown: "---\n---\n# Heading\n",
+			expected: map[string]any{},
+		},
+		{
+			name:     "missing frontmatter",
+			markdown: "# Heading\n\nNo metadata here.",
+			expected: map[string]any{},
+		},
+		{
+			name:     "invalid frontmatter",
+			markdown: "---\ntitle: [unterminated\n---\n# Heading\n",
+			expected: map[string]any{},
+		},
+	}
+
+	parser := NewParser()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := parser.ParseString(tt.markdown)
+			require.NoError(t, err)
+			require.NotNil(t, doc.Metadata)
+			assert.Equal(t, tt.expected, doc.Metadata)
+
+			// Frontmatter must not leak into the document body
+			var headings []*Heading
+			walker := NewWalker(WalkFunc(func(node Node) error {
+				if heading, ok := node.(*Heading); ok {
+					headings = append(headings, heading)
+				}
+				return nil
+			}))
+			require.NoError(t, walker.Walk(doc))
+			for _, heading := range headings {
+				assert.NotContains(t, heading.Text, "---")
+			}
+		})
+	}
+}
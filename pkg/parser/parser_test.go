@@ -230,3 +230,223 @@ Paragraph`
 		t.Errorf("Expected heading on line 1, got line %d", headingRange.Start.Line)
 	}
 }
+
+func TestFindHeadingByAnchor(t *testing.T) {
+	parser := NewParser()
+	source := `# Design
+
+## Goals
+
+## Goals & Non-Goals!
+
+## Notes
+
+## Notes
+
+See [[design#Goals]].`
+
+	doc, err := parser.ParseString(source)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	tests := []struct {
+		anchor   string
+		wantLine int
+	}{
+		{anchor: "Goals", wantLine: 3},
+		{anchor: "goals", wantLine: 3},
+		{anchor: "Goals & Non-Goals!", wantLine: 5},
+		{anchor: "goals--non-goals", wantLine: 5},
+		{anchor: "Notes", wantLine: 7},
+		{anchor: "notes-1", wantLine: 9},
+		{anchor: "Missing", wantLine: 0},
+	}
+
+	for _, tt := range tests {
+		heading := doc.FindHeadingByAnchor(tt.anchor)
+		if tt.wantLine == 0 {
+			if heading != nil {
+				t.Errorf("Anchor %q: expected no heading, got %q", tt.anchor, heading.Text)
+			}
+			continue
+		}
+		if heading == nil {
+			t.Errorf("Anchor %q: expected heading on line %d, got nil", tt.anchor, tt.wantLine)
+			continue
+		}
+		if heading.Range().Start.Line != tt.wantLine {
+			t.Errorf("Anchor %q: expected heading on line %d, got line %d", tt.anchor, tt.wantLine, heading.Range().Start.Line)
+		}
+	}
+
+	// The wikilink anchor is carried through to the document tree
+	var wikilink *Wikilink
+	walker := NewWalker(WalkFunc(func(node Node) error {
+		if wl, ok := node.(*Wikilink); ok {
+			wikilink = wl
+		}
+		return nil
+	}))
+	if err := walker.Walk(doc); err != nil {
+		t.Fatalf("Error walking tree: %v", err)
+	}
+	if wikilink == nil {
+		t.Fatal("Expected to find a wikilink")
+	}
+	if wikilink.Target != "design" || wikilink.Anchor != "Goals" {
+		t.Errorf("Expected target 'design' with anchor 'Goals', got %q with anchor %q", wikilink.Target, wikilink.Anchor)
+	}
+}
+
+func TestHeadingSlug(t *testing.T) {
+	tests := map[string]string{
+		"Goals":              "goals",
+		"Error Handling":     "error-handling",
+		"Goals & Non-Goals!": "goals--non-goals",
+		"  API (v2)  ":       "api-v2",
+		"snake_case":         "snake_case",
+	}
+
+	for input, want := range tests {
+		if got := HeadingSlug(input); got != want {
+			t.Errorf("HeadingSlug(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestFindBlockByID(t *testing.T) {
+	parser := NewParser()
+	source := `# Tasks
+
+- [ ] Write the proposal ^abc
+- Plain item ^note-1
+- Item with 2^10 in the middle
+  - [x] Nested task ^nested_2
+- Item without anchor`
+
+	doc, err := parser.ParseString(source)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	tests := []struct {
+		id       string
+		wantLine int
+		wantTask bool
+	}{
+		{id: "abc", wantLine: 3, wantTask: true},
+		{id: "^abc", wantLine: 3, wantTask: true},
+		{id: "note-1", wantLine: 4},
+		{id: "nested_2", wantLine: 6, wantTask: true},
+		{id: "10", wantLine: 0},
+		{id: "missing", wantLine: 0},
+		{id: "^", wantLine: 0},
+	}
+
+	for _, tt := range tests {
+		item := doc.FindBlockByID(tt.id)
+		if tt.wantLine == 0 {
+			if item != nil {
+				t.Errorf("Block %q: expected no list item, got one on line %d", tt.id, item.Range().Start.Line)
+			}
+			continue
+		}
+		if item == nil {
+			t.Errorf("Block %q: expected list item on line %d, got nil", tt.id, tt.wantLine)
+			continue
+		}
+		if item.Range().Start.Line != tt.wantLine {
+			t.Errorf("Block %q: expected list item on line %d, got line %d", tt.id, tt.wantLine, item.Range().Start.Line)
+		}
+		if item.TaskList != tt.wantTask {
+			t.Errorf("Block %q: expected task list %v, got %v", tt.id, tt.wantTask, item.TaskList)
+		}
+	}
+
+	// A reference from another document resolves through its anchor
+	reference, err := parser.ParseString("Follow up on [[tasks#^abc]].")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var wikilink *Wikilink
+	walker := NewWalker(WalkFunc(func(node Node) error {
+		if wl, ok := node.(*Wikilink); ok {
+			wikilink = wl
+		}
+		return nil
+	}))
+	if err := walker.Walk(reference); err != nil {
+		t.Fatalf("Error walking tree: %v", err)
+	}
+	if wikilink == nil {
+		t.Fatal("Expected to find a wikilink")
+	}
+	if wikilink.Target != "tasks" || wikilink.Anchor != "^abc" {
+		t.Fatalf("Expected target 'tasks' with anchor '^abc', got %q with anchor %q", wikilink.Target, wikilink.Anchor)
+	}
+	if item := doc.FindBlockByID(wikilink.Anchor); item == nil || item.Range().Start.Line != 3 {
+		t.Errorf("Expected anchor %q to resolve to the task on line 3", wikilink.Anchor)
+	}
+}
+
+func TestParseWikilinksSkipCode(t *testing.T) {
+	parser := NewParser()
+	source := "# Notes\n" +
+		"\n" +
+		"Inline `[[in-code]]` and ``[[double-`tick]]`` spans, but [[real-link]] counts.\n" +
+		"\n" +
+		"```markdown\n" +
+		"[[fenced-backtick]]\n" +
+		"```\n" +
+		"\n" +
+		"~~~\n" +
+		"[[fenced-tilde]]\n" +
+		"~~~\n" +
+		"\n" +
+		"    [[indented-code]]\n" +
+		"\n" +
+		"- Item with [[list-link]]\n" +
+		"\n" +
+		"  ```\n" +
+		"  [[fenced-in-list]]\n" +
+		"  ```\n" +
+		"\n" +
+		"After the fences [[after-link|display]]."
+
+	doc, err := parser.ParseString(source)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	found := make(map[string]int)
+	walker := NewWalker(WalkFunc(func(node Node) error {
+		if wikilink, ok := node.(*Wikilink); ok {
+			found[wikilink.Target] = wikilink.Range().Start.Line
+		}
+		return nil
+	}))
+	if err := walker.Walk(doc); err != nil {
+		t.Fatalf("Error walking tree: %v", err)
+	}
+
+	expected := map[string]int{
+		"real-link":  3,
+		"list-link":  15,
+		"after-link": 21,
+	}
+	if len(found) != len(expected) {
+		t.Errorf("Expected %d wikilinks, got %d: %v", len(expected), len(found), found)
+	}
+	for target, line := range expected {
+		got, ok := found[target]
+		if !ok {
+			t.Errorf("Expected wikilink %q to be extracted", target)
+			continue
+		}
+		if got != line {
+			t.Errorf("Wikilink %q: expected line %d, got %d", target, line, got)
+		}
+	}
+}
@@ -14,7 +14,11 @@
 
 package parser
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
 
 // Position represents a position in the source document
 type Position struct {
@@ -42,6 +46,9 @@ const (
 	NodeList
 	NodeListItem
 	NodeThematicBreak
+	NodeFootnoteDefinition
+	NodeCallout
+	NodeCalloutTitle
 
 	// Inline nodes
 	NodeText
@@ -52,6 +59,7 @@ const (
 	NodeWikilink
 	NodeAutoLink
 	NodeRawHTML
+	NodeFootnoteReference
 
 	// Container nodes
 	NodeContainer
@@ -76,6 +84,12 @@ func (nt NodeType) String() string {
 		return "ListItem"
 	case NodeThematicBreak:
 		return "ThematicBreak"
+	case NodeFootnoteDefinition:
+		return "FootnoteDefinition"
+	case NodeCallout:
+		return "Callout"
+	case NodeCalloutTitle:
+		return "CalloutTitle"
 	case NodeText:
 		return "Text"
 	case NodeEmphasis:
@@ -92,6 +106,8 @@ func (nt NodeType) String() string {
 		return "AutoLink"
 	case NodeRawHTML:
 		return "RawHTML"
+	case NodeFootnoteReference:
+		return "FootnoteReference"
 	case NodeContainer:
 		return "Container"
 	default:
@@ -287,10 +303,11 @@ func NewLink(url, title string, rng Range) *Link {
 	}
 }
 
-// Wikilink represents a wikilink node ([[page]] or [[page|display]])
+// Wikilink represents a wikilink node ([[page]], [[page|display]] or [[page#heading]])
 type Wikilink struct {
 	*BaseNode
 	Target       string
+	Anchor       string // Heading anchor after # (empty target means the current document)
 	DisplayText  string
 	HasPipe      bool  // Whether this wikilink has a pipe separator
 	ConcealRange Range // Range of text that should be concealed (target| portion)
@@ -323,6 +340,81 @@ func (w *Wikilink) Accept(visitor Visitor) error {
 	return visitor.Visit(w)
 }
 
+// FootnoteReference represents a footnote reference ([^label])
+type FootnoteReference struct {
+	*BaseNode
+	Label string
+}
+
+// NewFootnoteReference creates a new footnote reference node
+func NewFootnoteReference(label string, rng Range) *FootnoteReference {
+	return &FootnoteReference{
+		BaseNode: NewBaseNode(NodeFootnoteReference, rng),
+		Label:    label,
+	}
+}
+
+// Accept implements the visitor pattern for FootnoteReference
+func (f *FootnoteReference) Accept(visitor Visitor) error {
+	return visitor.Visit(f)
+}
+
+// FootnoteDefinition represents a footnote definition ([^label]: text), its content is held as children
+type FootnoteDefinition struct {
+	*BaseNode
+	Label string
+}
+
+// NewFootnoteDefinition creates a new footnote definition node
+func NewFootnoteDefinition(label string, rng Range) *FootnoteDefinition {
+	return &FootnoteDefinition{
+		BaseNode: NewBaseNode(NodeFootnoteDefinition, rng),
+		Label:    label,
+	}
+}
+
+// Accept implements the visitor pattern for FootnoteDefinition
+func (f *FootnoteDefinition) Accept(visitor Visitor) error {
+	return visitor.Visit(f)
+}
+
+// Callout represents a callout blockquote (> [!TYPE] title), its title and body are held as children
+type Callout struct {
+	*BaseNode
+	CalloutType string // Lowercased callout type (e.g., "note", "warning")
+	Title       string // Optional title following the type marker, parsed content is a CalloutTitle child
+	Foldable    bool   // Callout has a "+" or "-" fold marker
+	Collapsed   bool   // Callout is collapsed by default ("-" fold marker)
+}
+
+// NewCallout creates a new callout node
+func NewCallout(calloutType, title string, foldable, collapsed bool, rng Range) *Callout {
+	return &Callout{
+		BaseNode:    NewBaseNode(NodeCallout, rng),
+		CalloutType: calloutType,
+		Title:       title,
+		Foldable:    foldable,
+		Collapsed:   collapsed,
+	}
+}
+
+// Accept implements the visitor pattern for Callout
+func (c *Callout) Accept(visitor Visitor) error {
+	return visitor.Visit(c)
+}
+
+// CalloutTitle holds the inline content of a callout title
+type CalloutTitle struct {
+	*BaseNode
+}
+
+// NewCalloutTitle creates a new callout title node
+func NewCalloutTitle(rng Range) *CalloutTitle {
+	return &CalloutTitle{
+		BaseNode: NewBaseNode(NodeCalloutTitle, rng),
+	}
+}
+
 // List represents a list node
 type List struct {
 	*BaseNode
@@ -355,6 +447,7 @@ type ListItem struct {
 	*BaseNode
 	TaskList  bool
 	TaskState string // The actual task state value (e.g., " ", "x", "wip", "in-progress")
+	BlockID   string // Block anchor from a trailing "^id" on the item's first line, without the caret
 }
 
 // NewListItem creates a new list item node
@@ -427,6 +520,145 @@ func (d *Document) FindListItemAtLine(line int) *ListItem {
 	return result
 }
 
+// FindBlockByID finds the list item carrying the given block anchor, with or without the leading "^"
+func (d *Document) FindBlockByID(id string) *ListItem {
+	id = strings.TrimPrefix(id, "^")
+	if id == "" {
+		return nil
+	}
+
+	var result *ListItem
+	walker := NewWalker(WalkFunc(func(node Node) error {
+		if listItem, ok := node.(*ListItem); ok && result == nil && listItem.BlockID == id {
+			result = listItem
+		}
+		return nil
+	}))
+
+	_ = walker.Walk(d)
+	return result
+}
+
+// FindFootnoteDefinition finds the first definition for a footnote label, labels match case-insensitively
+func (d *Document) FindFootnoteDefinition(label string) *FootnoteDefinition {
+	for _, definition := range d.footnoteDefinitions() {
+		if strings.EqualFold(definition.Label, label) {
+			return definition
+		}
+	}
+	return nil
+}
+
+// UndefinedFootnoteReferences returns the footnote references that have no matching definition
+func (d *Document) UndefinedFootnoteReferences() []*FootnoteReference {
+	var undefined []*FootnoteReference
+	for _, reference := range d.footnoteReferences() {
+		if d.FindFootnoteDefinition(reference.Label) == nil {
+			undefined = append(undefined, reference)
+		}
+	}
+	return undefined
+}
+
+// UnusedFootnoteDefinitions returns the footnote definitions that are never referenced
+func (d *Document) UnusedFootnoteDefinitions() []*FootnoteDefinition {
+	references := d.footnoteReferences()
+
+	var unused []*FootnoteDefinition
+	for _, definition := range d.footnoteDefinitions() {
+		used := false
+		for _, reference := range references {
+			if strings.EqualFold(reference.Label, definition.Label) {
+				used = true
+				break
+			}
+		}
+		if !used {
+			unused = append(unused, definition)
+		}
+	}
+	return unused
+}
+
+// footnoteReferences collects all footnote references in document order
+func (d *Document) footnoteReferences() []*FootnoteReference {
+	var references []*FootnoteReference
+	walker := NewWalker(WalkFunc(func(node Node) error {
+		if reference, ok := node.(*FootnoteReference); ok {
+			references = append(references, reference)
+		}
+		return nil
+	}))
+
+	_ = walker.Walk(d)
+	return references
+}
+
+// footnoteDefinitions collects all footnote definitions in document order
+func (d *Document) footnoteDefinitions() []*FootnoteDefinition {
+	var definitions []*FootnoteDefinition
+	walker := NewWalker(WalkFunc(func(node Node) error {
+		if definition, ok := node.(*FootnoteDefinition); ok {
+			definitions = append(definitions, definition)
+		}
+		return nil
+	}))
+
+	_ = walker.Walk(d)
+	return definitions
+}
+
+// FindHeadingByAnchor finds the heading referenced by a wikilink anchor. The anchor may be
+// the heading text (case-insensitive) or its slug, with duplicate slugs suffixed "-1", "-2", ...
+func (d *Document) FindHeadingByAnchor(anchor string) *Heading {
+	var headings []*Heading
+	walker := NewWalker(WalkFunc(func(node Node) error {
+		if heading, ok := node.(*Heading); ok {
+			headings = append(headings, heading)
+		}
+		return nil
+	}))
+	_ = walker.Walk(d)
+
+	for _, heading := range headings {
+		if strings.EqualFold(strings.TrimSpace(heading.Text), strings.TrimSpace(anchor)) {
+			return heading
+		}
+	}
+
+	target := HeadingSlug(anchor)
+	seen := make(map[string]int)
+	for _, heading := range headings {
+		slug := HeadingSlug(heading.Text)
+		unique := slug
+		if count := seen[slug]; count > 0 {
+			unique = fmt.Sprintf("%s-%d", slug, count)
+		}
+		seen[slug]++
+
+		if unique == target {
+			return heading
+		}
+	}
+
+	return nil
+}
+
+// HeadingSlug converts heading text to an anchor slug: lowercased, spaces to hyphens
+// and punctuation removed (e.g., "Goals & Non-Goals!" becomes "goals--non-goals")
+func HeadingSlug(text string) string {
+	var slug strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(text)) {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r), r == '-', r == '_':
+			slug.WriteRune(r)
+		case unicode.IsSpace(r):
+			slug.WriteRune('-')
+		}
+	}
+	return slug.String()
+}
+
 // FindParentList finds the parent List node for a given ListItem
 func (li *ListItem) FindParentList() *List {
 	parent := li.Parent()
@@ -58,7 +58,7 @@ func NewDocumentLoader() *DocumentLoader {
 }
 
 // ProcessDocumentsPipeline processes documents using a fan-out/fan-in pipeline
-func (dl *DocumentLoader) processDocumentsPipeline(ctx context.Context, filesChan <-chan *DocumentFile, filter *v1alpha1.FilterExpression) ([]*v1alpha1.Document, error) {
+func (dl *DocumentLoader) processDocumentsPipeline(ctx context.Context, filesChan <-chan *DocumentFile, filter *compiledFilter) ([]*v1alpha1.Document, error) {
 	parsedChan := make(chan *ParsedDocument)
 	filteredChan := make(chan *ParsedDocument)
 	resultsChan := make(chan *v1alpha1.Document)
@@ -262,7 +262,7 @@ func (dl *DocumentLoader) parseStage(ctx context.Context, filesChan <-chan *Docu
 }
 
 // filterStage filters documents based on metadata
-func (dl *DocumentLoader) filterStage(ctx context.Context, parsedChan <-chan *ParsedDocument, filteredChan chan<- *ParsedDocument, filter *v1alpha1.FilterExpression, wg *sync.WaitGroup) {
+func (dl *DocumentLoader) filterStage(ctx context.Context, parsedChan <-chan *ParsedDocument, filteredChan chan<- *ParsedDocument, filter *compiledFilter, wg *sync.WaitGroup) {
 	defer wg.Done()
 	defer close(filteredChan) // Close output when done
 
@@ -280,7 +280,7 @@ func (dl *DocumentLoader) filterStage(ctx context.Context, parsedChan <-chan *Pa
 
 			// Apply filter if provided
 			if filter != nil {
-				matches, err := EvaluateFilter(filter, parsed.Metadata)
+				matches, err := filter.evaluate(filter.expression, parsed.Metadata)
 				if err != nil || !matches {
 					continue // Skip filtered out documents
 				}
@@ -370,6 +370,7 @@ func (dl *DocumentLoader) extractWikilinks(doc *parser.Document) []*v1alpha1.Wik
 
 			wikilinks = append(wikilinks, &v1alpha1.Wikilink{
 				Target:      wikilink.Target,
+				Anchor:      wikilink.Anchor,
 				DisplayText: displayText,
 				Line:        int32(line),   // #nosec G115 - bounds checked above
 				Column:      int32(column), // #nosec G115 - bounds checked above
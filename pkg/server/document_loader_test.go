@@ -0,0 +1,84 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/notedownorg/notedown/apis/go/application_server/v1alpha1"
+	"github.com/notedownorg/notedown/pkg/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocumentLoader_ExtractWikilinks(t *testing.T) {
+	loader := NewDocumentLoader()
+
+	t.Run("wikilinks in callout titles", func(t *testing.T) {
+		doc, err := parser.NewParser().ParseString("> [!NOTE] See [[design]] now\n> Body with [[other]]\n")
+		require.NoError(t, err)
+
+		wikilinks := loader.extractWikilinks(doc)
+		require.Len(t, wikilinks, 2)
+		assert.Equal(t, "design", wikilinks[0].Target)
+		assert.Equal(t, int32(1), wikilinks[0].Line)
+		assert.Equal(t, int32(15), wikilinks[0].Column)
+		assert.Equal(t, "other", wikilinks[1].Target)
+		assert.Equal(t, int32(2), wikilinks[1].Line)
+	})
+
+	t.Run("heading anchors", func(t *testing.T) {
+		doc, err := parser.NewParser().ParseString("# Summary\n\nSee [[#Summary]], [[design#Goals]] and [[design#Goals|goals]].\n")
+		require.NoError(t, err)
+
+		wikilinks := loader.extractWikilinks(doc)
+		require.Len(t, wikilinks, 3)
+
+		// An empty target refers to the current document
+		assert.Empty(t, wikilinks[0].Target)
+		assert.Equal(t, "Summary", wikilinks[0].Anchor)
+		assert.Empty(t, wikilinks[0].DisplayText)
+
+		assert.Equal(t, "design", wikilinks[1].Target)
+		assert.Equal(t, "Goals", wikilinks[1].Anchor)
+
+		assert.Equal(t, "design", wikilinks[2].Target)
+		assert.Equal(t, "Goals", wikilinks[2].Anchor)
+		assert.Equal(t, "goals", wikilinks[2].DisplayText)
+	})
+}
+
+func TestDocumentServer_ListDocumentsHeadingAnchors(t *testing.T) {
+	workspace := t.TempDir()
+	writeWorkspaceFiles(t, workspace, map[string]string{
+		"note.md": "# Summary\n\nBack to [[#Summary]] or on to [[design#Goals]].\n",
+	})
+
+	server, err := NewDocumentServer(workspace)
+	require.NoError(t, err)
+
+	resp, err := server.ListDocuments(context.Background(), &v1alpha1.ListDocumentsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Documents, 1)
+
+	wikilinks := resp.Documents[0].Wikilinks
+	require.Len(t, wikilinks, 2)
+	assert.Empty(t, wikilinks[0].Target, "same-document links have an empty target")
+	assert.Equal(t, "Summary", wikilinks[0].Anchor)
+	assert.Equal(t, int32(3), wikilinks[0].Line)
+	assert.Equal(t, "design", wikilinks[1].Target)
+	assert.Equal(t, "Goals", wikilinks[1].Anchor)
+}
@@ -17,41 +17,120 @@ package server
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/notedownorg/notedown/apis/go/application_server/v1alpha1"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
+// compiledFilter is a filter expression whose regex_match patterns have been compiled up front,
+// so they are validated once per request instead of once per document
+type compiledFilter struct {
+	expression *v1alpha1.FilterExpression
+	patterns   map[string]*regexp.Regexp
+}
+
+// compileFilter validates a filter expression and compiles every regex_match pattern it contains
+func compileFilter(filter *v1alpha1.FilterExpression) (*compiledFilter, error) {
+	compiled := &compiledFilter{expression: filter, patterns: make(map[string]*regexp.Regexp)}
+	if err := compiled.compilePatterns(filter); err != nil {
+		return nil, err
+	}
+	return compiled, nil
+}
+
+// compilePatterns walks a filter expression and compiles the patterns of its regex_match filters
+func (f *compiledFilter) compilePatterns(filter *v1alpha1.FilterExpression) error {
+	if filter == nil {
+		return nil
+	}
+
+	switch expr := filter.Expression.(type) {
+	case *v1alpha1.FilterExpression_MetadataFilter:
+		metadataFilter := expr.MetadataFilter
+		if metadataFilter == nil || metadataFilter.Operator != v1alpha1.MetadataOperator_METADATA_OPERATOR_REGEX_MATCH {
+			return nil
+		}
+		value, err := protoValueToGoValue(metadataFilter.Value)
+		if err != nil {
+			return fmt.Errorf("failed to convert filter value: %w", err)
+		}
+		pattern, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("regex_match operator requires string pattern")
+		}
+		if _, ok := f.patterns[pattern]; ok {
+			return nil
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid regex pattern %q: %w", pattern, err)
+		}
+		f.patterns[pattern] = re
+	case *v1alpha1.FilterExpression_AndFilter:
+		if expr.AndFilter != nil {
+			for _, subFilter := range expr.AndFilter.Filters {
+				if err := f.compilePatterns(subFilter); err != nil {
+					return err
+				}
+			}
+		}
+	case *v1alpha1.FilterExpression_OrFilter:
+		if expr.OrFilter != nil {
+			for _, subFilter := range expr.OrFilter.Filters {
+				if err := f.compilePatterns(subFilter); err != nil {
+					return err
+				}
+			}
+		}
+	case *v1alpha1.FilterExpression_NotFilter:
+		if expr.NotFilter != nil {
+			return f.compilePatterns(expr.NotFilter.Filter)
+		}
+	}
+	return nil
+}
+
 // EvaluateFilter evaluates a filter expression against document metadata
 func EvaluateFilter(filter *v1alpha1.FilterExpression, metadata map[string]any) (bool, error) {
+	compiled, err := compileFilter(filter)
+	if err != nil {
+		return false, err
+	}
+	return compiled.evaluate(compiled.expression, metadata)
+}
+
+// evaluate evaluates a filter expression, or one of its sub-expressions, against document metadata
+func (f *compiledFilter) evaluate(filter *v1alpha1.FilterExpression, metadata map[string]any) (bool, error) {
 	if filter == nil {
 		return true, nil // No filter means all documents match
 	}
 
 	switch expr := filter.Expression.(type) {
 	case *v1alpha1.FilterExpression_MetadataFilter:
-		return evaluateMetadataFilter(expr.MetadataFilter, metadata)
+		return f.evaluateMetadataFilter(expr.MetadataFilter, metadata)
 	case *v1alpha1.FilterExpression_AndFilter:
-		return evaluateAndFilter(expr.AndFilter, metadata)
+		return f.evaluateAndFilter(expr.AndFilter, metadata)
 	case *v1alpha1.FilterExpression_OrFilter:
-		return evaluateOrFilter(expr.OrFilter, metadata)
+		return f.evaluateOrFilter(expr.OrFilter, metadata)
 	case *v1alpha1.FilterExpression_NotFilter:
-		return evaluateNotFilter(expr.NotFilter, metadata)
+		return f.evaluateNotFilter(expr.NotFilter, metadata)
 	default:
 		return false, fmt.Errorf("unknown filter expression type: %T", expr)
 	}
 }
 
 // evaluateMetadataFilter evaluates a metadata filter
-func evaluateMetadataFilter(filter *v1alpha1.MetadataFilter, metadata map[string]any) (bool, error) {
+func (f *compiledFilter) evaluateMetadataFilter(filter *v1alpha1.MetadataFilter, metadata map[string]any) (bool, error) {
 	if filter == nil {
 		return true, nil
 	}
 
 	// Get the field value from metadata
-	fieldValue, exists := metadata[filter.Field]
+	fieldValue, exists := lookupField(metadata, filter.Field)
 
 	switch filter.Operator {
 	case v1alpha1.MetadataOperator_METADATA_OPERATOR_EXISTS:
@@ -71,17 +150,53 @@ func evaluateMetadataFilter(filter *v1alpha1.MetadataFilter, metadata map[string
 		return false, fmt.Errorf("failed to convert filter value: %w", err)
 	}
 
+	if filter.Operator == v1alpha1.MetadataOperator_METADATA_OPERATOR_REGEX_MATCH {
+		pattern, _ := filterValue.(string)
+		return matchesRegex(fieldValue, f.patterns[pattern])
+	}
 	return compareValues(fieldValue, filterValue, filter.Operator)
 }
 
+// lookupField resolves a field name or dot path such as "project.status" or "authors.0"
+// against metadata, keys that themselves contain dots take precedence over traversal
+func lookupField(metadata map[string]any, field string) (any, bool) {
+	if value, ok := metadata[field]; ok {
+		return value, true
+	}
+	if !strings.Contains(field, ".") {
+		return nil, false
+	}
+
+	var current any = metadata
+	for _, segment := range strings.Split(field, ".") {
+		switch v := current.(type) {
+		case map[string]any:
+			value, ok := v[segment]
+			if !ok {
+				return nil, false
+			}
+			current = value
+		case []any:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil, false
+			}
+			current = v[index]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
 // evaluateAndFilter evaluates an AND filter (all must be true)
-func evaluateAndFilter(filter *v1alpha1.AndFilter, metadata map[string]any) (bool, error) {
+func (f *compiledFilter) evaluateAndFilter(filter *v1alpha1.AndFilter, metadata map[string]any) (bool, error) {
 	if filter == nil || len(filter.Filters) == 0 {
 		return true, nil
 	}
 
 	for _, subFilter := range filter.Filters {
-		result, err := EvaluateFilter(subFilter, metadata)
+		result, err := f.evaluate(subFilter, metadata)
 		if err != nil {
 			return false, err
 		}
@@ -93,13 +208,13 @@ func evaluateAndFilter(filter *v1alpha1.AndFilter, metadata map[string]any) (boo
 }
 
 // evaluateOrFilter evaluates an OR filter (any must be true)
-func evaluateOrFilter(filter *v1alpha1.OrFilter, metadata map[string]any) (bool, error) {
+func (f *compiledFilter) evaluateOrFilter(filter *v1alpha1.OrFilter, metadata map[string]any) (bool, error) {
 	if filter == nil || len(filter.Filters) == 0 {
 		return true, nil
 	}
 
 	for _, subFilter := range filter.Filters {
-		result, err := EvaluateFilter(subFilter, metadata)
+		result, err := f.evaluate(subFilter, metadata)
 		if err != nil {
 			return false, err
 		}
@@ -111,12 +226,12 @@ func evaluateOrFilter(filter *v1alpha1.OrFilter, metadata map[string]any) (bool,
 }
 
 // evaluateNotFilter evaluates a NOT filter
-func evaluateNotFilter(filter *v1alpha1.NotFilter, metadata map[string]any) (bool, error) {
+func (f *compiledFilter) evaluateNotFilter(filter *v1alpha1.NotFilter, metadata map[string]any) (bool, error) {
 	if filter == nil || filter.Filter == nil {
 		return true, nil
 	}
 
-	result, err := EvaluateFilter(filter.Filter, metadata)
+	result, err := f.evaluate(filter.Filter, metadata)
 	if err != nil {
 		return false, err
 	}
@@ -149,6 +264,10 @@ func compareValues(fieldValue, filterValue any, operator v1alpha1.MetadataOperat
 	case v1alpha1.MetadataOperator_METADATA_OPERATOR_NOT_IN:
 		result, err := inArray(fieldValue, filterValue)
 		return !result, err
+	case v1alpha1.MetadataOperator_METADATA_OPERATOR_DATE_BEFORE:
+		return compareDates(fieldValue, filterValue, "<"), nil
+	case v1alpha1.MetadataOperator_METADATA_OPERATOR_DATE_AFTER:
+		return compareDates(fieldValue, filterValue, ">"), nil
 	default:
 		return false, fmt.Errorf("unsupported operator: %v", operator)
 	}
@@ -229,6 +348,48 @@ func compareNumeric(fieldValue, filterValue any, operator string) (bool, error)
 	}
 }
 
+// matchesRegex checks if a string field, or any string element of an array field, matches a compiled pattern
+func matchesRegex(fieldValue any, re *regexp.Regexp) (bool, error) {
+	if re == nil {
+		return false, fmt.Errorf("regex_match pattern was not compiled")
+	}
+
+	if fieldStr, ok := fieldValue.(string); ok {
+		return re.MatchString(fieldStr), nil
+	}
+
+	// Match against each string element of an array field
+	fieldSlice := reflect.ValueOf(fieldValue)
+	if fieldSlice.Kind() == reflect.Slice || fieldSlice.Kind() == reflect.Array {
+		for i := 0; i < fieldSlice.Len(); i++ {
+			if elemStr, ok := fieldSlice.Index(i).Interface().(string); ok && re.MatchString(elemStr) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// compareDates compares date values chronologically, values that are not dates never match
+func compareDates(fieldValue, filterValue any, operator string) bool {
+	fieldDate, ok1 := toTime(fieldValue)
+	filterDate, ok2 := toTime(filterValue)
+
+	if !ok1 || !ok2 {
+		return false
+	}
+
+	switch operator {
+	case "<":
+		return fieldDate.Before(filterDate)
+	case ">":
+		return fieldDate.After(filterDate)
+	default:
+		return false
+	}
+}
+
 // inArray checks if fieldValue is in the filterValue array
 func inArray(fieldValue, filterValue any) (bool, error) {
 	filterSlice := reflect.ValueOf(filterValue)
@@ -264,6 +425,21 @@ func toFloat64(value any) (float64, error) {
 	}
 }
 
+// toTime converts RFC3339 or YYYY-MM-DD strings and decoded YAML timestamps to time.Time
+func toTime(value any) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case string:
+		for _, layout := range []string{time.RFC3339, time.DateOnly} {
+			if t, err := time.Parse(layout, v); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
 // protoValueToGoValue converts protobuf Value to Go value
 func protoValueToGoValue(value *structpb.Value) (any, error) {
 	if value == nil {
@@ -16,6 +16,7 @@ package server
 
 import (
 	"testing"
+	"time"
 
 	"github.com/notedownorg/notedown/apis/go/application_server/v1alpha1"
 	"github.com/stretchr/testify/assert"
@@ -222,6 +223,186 @@ func TestEvaluateFilter(t *testing.T) {
 		assert.True(t, result)
 	})
 
+	t.Run("regex match operator", func(t *testing.T) {
+		tests := []struct {
+			field    string
+			pattern  string
+			expected bool
+		}{
+			{"title", "^Test", true},
+			{"title", "^Document", false},
+			{"title", "Document$", true},
+			{"status", "^(active|pending)$", true},
+			{"title", "test document", false},
+			{"title", "(?i)^test document$", true},
+			{"tags", "^imp", true},
+			{"tags", "^archive", false},
+			{"count", "42", false},
+			{"draft", "false", false},
+		}
+
+		for _, test := range tests {
+			filter := &v1alpha1.FilterExpression{
+				Expression: &v1alpha1.FilterExpression_MetadataFilter{
+					MetadataFilter: &v1alpha1.MetadataFilter{
+						Field:    test.field,
+						Operator: v1alpha1.MetadataOperator_METADATA_OPERATOR_REGEX_MATCH,
+						Value:    structpb.NewStringValue(test.pattern),
+					},
+				},
+			}
+
+			result, err := EvaluateFilter(filter, metadata)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result, "field %s with pattern %q", test.field, test.pattern)
+		}
+
+		// Invalid patterns are reported as errors
+		filter := &v1alpha1.FilterExpression{
+			Expression: &v1alpha1.FilterExpression_MetadataFilter{
+				MetadataFilter: &v1alpha1.MetadataFilter{
+					Field:    "title",
+					Operator: v1alpha1.MetadataOperator_METADATA_OPERATOR_REGEX_MATCH,
+					Value:    structpb.NewStringValue("[unclosed"),
+				},
+			},
+		}
+
+		_, err := EvaluateFilter(filter, metadata)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid regex pattern")
+
+		// Invalid patterns are reported even when no document has the field
+		filter.GetMetadataFilter().Field = "missing"
+		_, err = EvaluateFilter(filter, metadata)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid regex pattern")
+	})
+
+	t.Run("regex patterns are compiled once per filter", func(t *testing.T) {
+		pattern := &v1alpha1.FilterExpression{
+			Expression: &v1alpha1.FilterExpression_MetadataFilter{
+				MetadataFilter: &v1alpha1.MetadataFilter{
+					Field:    "title",
+					Operator: v1alpha1.MetadataOperator_METADATA_OPERATOR_REGEX_MATCH,
+					Value:    structpb.NewStringValue("^Test"),
+				},
+			},
+		}
+		filter := &v1alpha1.FilterExpression{
+			Expression: &v1alpha1.FilterExpression_OrFilter{
+				OrFilter: &v1alpha1.OrFilter{Filters: []*v1alpha1.FilterExpression{pattern, pattern}},
+			},
+		}
+
+		compiled, err := compileFilter(filter)
+		require.NoError(t, err)
+		assert.Len(t, compiled.patterns, 1)
+
+		result, err := compiled.evaluate(compiled.expression, metadata)
+		require.NoError(t, err)
+		assert.True(t, result)
+	})
+
+	t.Run("date operators", func(t *testing.T) {
+		dateMetadata := map[string]any{
+			"date":      time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			"due":       "2024-07-15",
+			"updated":   "2024-06-01T12:30:00Z",
+			"malformed": "June 1st",
+			"count":     42,
+		}
+
+		tests := []struct {
+			field    string
+			operator v1alpha1.MetadataOperator
+			value    string
+			expected bool
+		}{
+			{"date", v1alpha1.MetadataOperator_METADATA_OPERATOR_DATE_BEFORE, "2024-06-02", true},
+			{"date", v1alpha1.MetadataOperator_METADATA_OPERATOR_DATE_BEFORE, "2024-06-01", false},
+			{"date", v1alpha1.MetadataOperator_METADATA_OPERATOR_DATE_AFTER, "2024-05-31", true},
+			{"date", v1alpha1.MetadataOperator_METADATA_OPERATOR_DATE_AFTER, "2025-01-01", false},
+			{"due", v1alpha1.MetadataOperator_METADATA_OPERATOR_DATE_AFTER, "2024-06-30", true},
+			{"due", v1alpha1.MetadataOperator_METADATA_OPERATOR_DATE_BEFORE, "2024-06-30", false},
+			{"due", v1alpha1.MetadataOperator_METADATA_OPERATOR_DATE_BEFORE, "2024-12-01T00:00:00Z", true},
+			{"updated", v1alpha1.MetadataOperator_METADATA_OPERATOR_DATE_AFTER, "2024-06-01", true},
+			{"updated", v1alpha1.MetadataOperator_METADATA_OPERATOR_DATE_BEFORE, "2024-06-01T12:00:00+02:00", false},
+			{"malformed", v1alpha1.MetadataOperator_METADATA_OPERATOR_DATE_BEFORE, "2030-01-01", false},
+			{"malformed", v1alpha1.MetadataOperator_METADATA_OPERATOR_DATE_AFTER, "1990-01-01", false},
+			{"due", v1alpha1.MetadataOperator_METADATA_OPERATOR_DATE_AFTER, "not a date", false},
+			{"count", v1alpha1.MetadataOperator_METADATA_OPERATOR_DATE_BEFORE, "2030-01-01", false},
+		}
+
+		for _, test := range tests {
+			filter := &v1alpha1.FilterExpression{
+				Expression: &v1alpha1.FilterExpression_MetadataFilter{
+					MetadataFilter: &v1alpha1.MetadataFilter{
+						Field:    test.field,
+						Operator: test.operator,
+						Value:    structpb.NewStringValue(test.value),
+					},
+				},
+			}
+
+			result, err := EvaluateFilter(filter, dateMetadata)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result, "field %s operator %v with value %q", test.field, test.operator, test.value)
+		}
+	})
+
+	t.Run("nested field paths", func(t *testing.T) {
+		nestedMetadata := map[string]any{
+			"project": map[string]any{
+				"name":   "alpha",
+				"status": "active",
+				"owner": map[string]any{
+					"team": "platform",
+				},
+			},
+			"authors":      []any{"alice", "bob"},
+			"title":        "Nested",
+			"release.date": "2024-06-01",
+		}
+
+		tests := []struct {
+			name     string
+			field    string
+			operator v1alpha1.MetadataOperator
+			value    *structpb.Value
+			expected bool
+		}{
+			{"nested string", "project.status", v1alpha1.MetadataOperator_METADATA_OPERATOR_EQUALS, structpb.NewStringValue("active"), true},
+			{"deep path", "project.owner.team", v1alpha1.MetadataOperator_METADATA_OPERATOR_EQUALS, structpb.NewStringValue("platform"), true},
+			{"array index", "authors.1", v1alpha1.MetadataOperator_METADATA_OPERATOR_EQUALS, structpb.NewStringValue("bob"), true},
+			{"array index out of range", "authors.2", v1alpha1.MetadataOperator_METADATA_OPERATOR_EXISTS, nil, false},
+			{"non-numeric array index", "authors.first", v1alpha1.MetadataOperator_METADATA_OPERATOR_EXISTS, nil, false},
+			{"missing intermediate key", "milestone.status", v1alpha1.MetadataOperator_METADATA_OPERATOR_EQUALS, structpb.NewStringValue("active"), false},
+			{"missing intermediate key not exists", "milestone.status", v1alpha1.MetadataOperator_METADATA_OPERATOR_NOT_EXISTS, nil, true},
+			{"path through non-object", "title.length", v1alpha1.MetadataOperator_METADATA_OPERATOR_EXISTS, nil, false},
+			{"path through array element", "authors.0.name", v1alpha1.MetadataOperator_METADATA_OPERATOR_EXISTS, nil, false},
+			{"literal dotted key", "release.date", v1alpha1.MetadataOperator_METADATA_OPERATOR_EQUALS, structpb.NewStringValue("2024-06-01"), true},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				filter := &v1alpha1.FilterExpression{
+					Expression: &v1alpha1.FilterExpression_MetadataFilter{
+						MetadataFilter: &v1alpha1.MetadataFilter{
+							Field:    test.field,
+							Operator: test.operator,
+							Value:    test.value,
+						},
+					},
+				}
+
+				result, err := EvaluateFilter(filter, nestedMetadata)
+				require.NoError(t, err)
+				assert.Equal(t, test.expected, result)
+			})
+		}
+	})
+
 	t.Run("exists operator", func(t *testing.T) {
 		filter := &v1alpha1.FilterExpression{
 			Expression: &v1alpha1.FilterExpression_MetadataFilter{
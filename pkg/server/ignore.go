@@ -0,0 +1,211 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// gitignoreFile is the name of the per-directory ignore file honoured during discovery
+const gitignoreFile = ".gitignore"
+
+// ignoreRule is a single compiled gitignore-style pattern
+type ignoreRule struct {
+	base     string // Slash-separated directory the pattern is relative to ("" for the workspace root)
+	regex    *regexp.Regexp
+	negate   bool
+	dirOnly  bool
+	anchored bool // Pattern contains a slash and matches against the full relative path
+}
+
+// ignoreMatcher evaluates workspace ignore patterns and nested .gitignore files
+type ignoreMatcher struct {
+	workspaceRoot string
+	rules         []ignoreRule
+	dirRules      map[string][]ignoreRule // Rules loaded from the .gitignore of each directory, keyed by relative path
+}
+
+// newIgnoreMatcher creates a matcher seeded with patterns relative to the workspace root
+func newIgnoreMatcher(workspaceRoot string, patterns []string) *ignoreMatcher {
+	im := &ignoreMatcher{
+		workspaceRoot: workspaceRoot,
+		dirRules:      make(map[string][]ignoreRule),
+	}
+	for _, pattern := range patterns {
+		if rule, ok := parseIgnoreRule(pattern, ""); ok {
+			im.rules = append(im.rules, rule)
+		}
+	}
+	return im
+}
+
+// isIgnored checks if a slash-separated path relative to the workspace root is ignored by either
+// the workspace configuration or the .gitignore files above it. Within each source the last
+// matching rule wins, so negations and deeper .gitignore files override earlier rules.
+func (im *ignoreMatcher) isIgnored(relPath string, isDir bool) bool {
+	if evaluateIgnoreRules(im.rules, relPath, isDir) {
+		return true
+	}
+
+	var rules []ignoreRule
+	dir := ""
+	for {
+		rules = append(rules, im.loadDirRules(dir)...)
+
+		rest := strings.TrimPrefix(strings.TrimPrefix(relPath, dir), "/")
+		next, _, found := strings.Cut(rest, "/")
+		if !found {
+			break
+		}
+		dir = path.Join(dir, next)
+	}
+
+	return evaluateIgnoreRules(rules, relPath, isDir)
+}
+
+// evaluateIgnoreRules applies rules in order and reports whether the last match ignores the path
+func evaluateIgnoreRules(rules []ignoreRule, relPath string, isDir bool) bool {
+	ignored := false
+	for _, rule := range rules {
+		if rule.matches(relPath, isDir) {
+			ignored = !rule.negate
+		}
+	}
+	return ignored
+}
+
+// loadDirRules reads and caches the .gitignore rules of a directory relative to the workspace root
+func (im *ignoreMatcher) loadDirRules(dir string) []ignoreRule {
+	if rules, ok := im.dirRules[dir]; ok {
+		return rules
+	}
+
+	var rules []ignoreRule
+	// #nosec G304 - path is from trusted workspace discovery
+	file, err := os.Open(filepath.Join(im.workspaceRoot, filepath.FromSlash(dir), gitignoreFile))
+	if err == nil {
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			if rule, ok := parseIgnoreRule(scanner.Text(), dir); ok {
+				rules = append(rules, rule)
+			}
+		}
+		_ = file.Close()
+	}
+
+	im.dirRules[dir] = rules
+	return rules
+}
+
+// matches checks if the rule applies to a slash-separated path relative to the workspace root
+func (r ignoreRule) matches(relPath string, isDir bool) bool {
+	if r.dirOnly && !isDir {
+		return false
+	}
+
+	if r.base != "" {
+		if !strings.HasPrefix(relPath, r.base+"/") {
+			return false
+		}
+		relPath = strings.TrimPrefix(relPath, r.base+"/")
+	}
+
+	if r.anchored {
+		return r.regex.MatchString(relPath)
+	}
+	return r.regex.MatchString(path.Base(relPath))
+}
+
+// parseIgnoreRule compiles a gitignore-style pattern line, returning false for blank lines and comments
+func parseIgnoreRule(line, base string) (ignoreRule, bool) {
+	pattern := strings.TrimRight(line, " \t\r")
+	if pattern == "" || strings.HasPrefix(pattern, "#") {
+		return ignoreRule{}, false
+	}
+
+	rule := ignoreRule{base: base}
+	if strings.HasPrefix(pattern, "!") {
+		rule.negate = true
+		pattern = pattern[1:]
+	} else if strings.HasPrefix(pattern, `\`) {
+		pattern = pattern[1:] // Escaped leading "#" or "!"
+	}
+
+	if strings.HasSuffix(pattern, "/") {
+		rule.dirOnly = true
+		pattern = strings.TrimRight(pattern, "/")
+	}
+	if strings.Contains(pattern, "/") {
+		rule.anchored = true
+		pattern = strings.TrimPrefix(pattern, "/")
+	}
+	if pattern == "" {
+		return ignoreRule{}, false
+	}
+
+	regex, err := regexp.Compile(globToRegex(pattern))
+	if err != nil {
+		return ignoreRule{}, false
+	}
+	rule.regex = regex
+	return rule, true
+}
+
+// globToRegex converts a gitignore glob, including "**" segments, to an anchored regular expression
+func globToRegex(pattern string) string {
+	var b strings.Builder
+	b.WriteString("^")
+
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '*' && strings.HasPrefix(pattern[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case c == '*' && strings.HasPrefix(pattern[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		case c == '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end == -1 {
+				b.WriteString(`\[`)
+				continue
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			i += end + 1
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			b.WriteString(regexp.QuoteMeta(string(pattern[i])))
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	b.WriteString("$")
+	return b.String()
+}
@@ -17,8 +17,12 @@ package server
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/notedownorg/notedown/apis/go/application_server/v1alpha1"
+	"github.com/notedownorg/notedown/pkg/commands"
 	"github.com/notedownorg/notedown/pkg/config"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -29,6 +33,7 @@ type DocumentServer struct {
 	v1alpha1.UnimplementedDocumentServiceServer
 
 	workspaceRoot       string
+	config              *config.Config
 	workspaceDiscoverer *workspaceDiscoverer
 	documentLoader      *DocumentLoader
 }
@@ -49,10 +54,16 @@ func NewDocumentServer(workspaceRoot string) (*DocumentServer, error) {
 		actualRoot = workspaceRoot
 	}
 
-	discoverer := newWorkspaceDiscoverer(actualRoot)
+	cfg, err := config.LoadConfig(actualRoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load workspace config: %w", err)
+	}
+
+	discoverer := newWorkspaceDiscoverer(actualRoot, cfg.Workspace)
 
 	return &DocumentServer{
 		workspaceRoot:       actualRoot,
+		config:              cfg,
 		workspaceDiscoverer: discoverer,
 		documentLoader:      NewDocumentLoader(),
 	}, nil
@@ -60,11 +71,21 @@ func NewDocumentServer(workspaceRoot string) (*DocumentServer, error) {
 
 // ListDocuments implements the ListDocuments RPC method
 func (ds *DocumentServer) ListDocuments(ctx context.Context, req *v1alpha1.ListDocumentsRequest) (*v1alpha1.ListDocumentsResponse, error) {
+	// Validate the filter before any documents are read so invalid patterns are reported to the caller
+	var filter *compiledFilter
+	if req.Filter != nil {
+		compiled, err := compileFilter(req.Filter)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		filter = compiled
+	}
+
 	// Discover all markdown files in workspace via channels
 	filesChan, errChan := ds.workspaceDiscoverer.discoverDocuments()
 
 	// Process documents through the fan-out/fan-in pipeline
-	documents, err := ds.documentLoader.processDocumentsPipeline(ctx, filesChan, req.Filter)
+	documents, err := ds.documentLoader.processDocumentsPipeline(ctx, filesChan, filter)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to process documents: %v", err)
 	}
@@ -82,6 +103,97 @@ func (ds *DocumentServer) ListDocuments(ctx context.Context, req *v1alpha1.ListD
 	return &v1alpha1.ListDocumentsResponse{Documents: documents}, nil
 }
 
+// ExecuteCommand implements the ExecuteCommand RPC method
+func (ds *DocumentServer) ExecuteCommand(ctx context.Context, req *v1alpha1.ExecuteCommandRequest) (*v1alpha1.ExecuteCommandResponse, error) {
+	if !commands.IsKnown(req.Command) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown command: %s", req.Command)
+	}
+
+	absPath, err := ds.resolveDocumentPath(req.Path)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid path: %v", err)
+	}
+
+	info, err := os.Stat(absPath)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to stat document %s: %v", req.Path, err)
+	}
+
+	content, err := os.ReadFile(absPath) // #nosec G304 - path is validated to be inside the workspace
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read document %s: %v", req.Path, err)
+	}
+
+	updated, err := commands.Apply(req.Command, string(content), ds.config)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to execute command: %v", err)
+	}
+
+	resp := &v1alpha1.ExecuteCommandResponse{}
+	if updated == string(content) {
+		return resp, nil
+	}
+
+	if err := os.WriteFile(absPath, []byte(updated), info.Mode().Perm()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write document %s: %v", req.Path, err)
+	}
+	resp.ChangedPaths = append(resp.ChangedPaths, filepath.ToSlash(filepath.Clean(req.Path)))
+
+	return resp, nil
+}
+
+// resolveDocumentPath converts a workspace-relative path to an absolute path of a workspace document.
+// Symlinks are resolved so the target must also be inside the workspace, and both the requested path
+// and its target must be notes that workspace discovery would return.
+func (ds *DocumentServer) resolveDocumentPath(path string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("path cannot be empty")
+	}
+	if filepath.IsAbs(path) {
+		return "", fmt.Errorf("path must be relative to the workspace root")
+	}
+
+	absPath := filepath.Join(ds.workspaceRoot, path)
+	rel, ok := relativeToRoot(ds.workspaceRoot, absPath)
+	if !ok {
+		return "", fmt.Errorf("path %s is outside the workspace", path)
+	}
+	if !ds.workspaceDiscoverer.isDiscoverable(rel) {
+		return "", fmt.Errorf("path %s is not a workspace document", path)
+	}
+
+	resolved, err := filepath.EvalSymlinks(absPath)
+	if os.IsNotExist(err) {
+		return absPath, nil // Reported as missing by the caller
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve path %s: %w", path, err)
+	}
+	resolvedRoot, err := filepath.EvalSymlinks(ds.workspaceRoot)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve workspace root: %w", err)
+	}
+
+	resolvedRel, ok := relativeToRoot(resolvedRoot, resolved)
+	if !ok {
+		return "", fmt.Errorf("path %s resolves outside the workspace", path)
+	}
+	if !ds.workspaceDiscoverer.isDiscoverable(resolvedRel) {
+		return "", fmt.Errorf("path %s does not resolve to a workspace document", path)
+	}
+
+	return resolved, nil
+}
+
+// relativeToRoot returns the path relative to root, reporting false if it is not inside root
+func relativeToRoot(root, path string) (string, bool) {
+	rel, err := filepath.Rel(root, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return rel, true
+}
+
 // GetWorkspaceRoot returns the workspace root path
 func (ds *DocumentServer) GetWorkspaceRoot() string {
 	return ds.workspaceRoot
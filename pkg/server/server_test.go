@@ -16,12 +16,17 @@ package server
 
 import (
 	"context"
+	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/notedownorg/notedown/apis/go/application_server/v1alpha1"
+	"github.com/notedownorg/notedown/pkg/commands"
+	"github.com/notedownorg/notedown/pkg/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -193,6 +198,52 @@ func TestDocumentServer_ListDocuments(t *testing.T) {
 		assert.Len(t, resp.Documents, 1)
 		assert.Equal(t, "project-notes.md", resp.Documents[0].Path)
 	})
+
+	t.Run("regex filter", func(t *testing.T) {
+		req := &v1alpha1.ListDocumentsRequest{
+			Filter: &v1alpha1.FilterExpression{
+				Expression: &v1alpha1.FilterExpression_MetadataFilter{
+					MetadataFilter: &v1alpha1.MetadataFilter{
+						Field:    "status",
+						Operator: v1alpha1.MetadataOperator_METADATA_OPERATOR_REGEX_MATCH,
+						Value:    structpb.NewStringValue("^act"),
+					},
+				},
+			},
+		}
+
+		resp, err := server.ListDocuments(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, resp.Documents, 1)
+		assert.Equal(t, "project-notes.md", resp.Documents[0].Path)
+	})
+
+	t.Run("invalid regex filter", func(t *testing.T) {
+		// The pattern is rejected even though the field exists in no document
+		req := &v1alpha1.ListDocumentsRequest{
+			Filter: &v1alpha1.FilterExpression{
+				Expression: &v1alpha1.FilterExpression_NotFilter{
+					NotFilter: &v1alpha1.NotFilter{
+						Filter: &v1alpha1.FilterExpression{
+							Expression: &v1alpha1.FilterExpression_MetadataFilter{
+								MetadataFilter: &v1alpha1.MetadataFilter{
+									Field:    "no-such-field",
+									Operator: v1alpha1.MetadataOperator_METADATA_OPERATOR_REGEX_MATCH,
+									Value:    structpb.NewStringValue("("),
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		resp, err := server.ListDocuments(ctx, req)
+		require.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "invalid regex pattern")
+	})
 }
 
 func TestDocumentServer_DocumentContent(t *testing.T) {
@@ -280,3 +331,183 @@ func TestDocumentServer_DocumentContent(t *testing.T) {
 		assert.Regexp(t, "^[a-f0-9]{64}$", projectDoc.Checksum)
 	})
 }
+
+func TestDocumentServer_ExecuteCommand(t *testing.T) {
+	workspace := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(workspace, ".notedown"), 0750))
+
+	content := `# Sprint
+
+- [ ] Write docs
+- [x] Ship parser
+  - [x] Add tests
+- [wip] Review PRs
+`
+	docPath := filepath.Join(workspace, "sprint.md")
+	require.NoError(t, os.WriteFile(docPath, []byte(content), 0600))
+
+	server, err := NewDocumentServer(workspace)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("archive completed tasks", func(t *testing.T) {
+		resp, err := server.ExecuteCommand(ctx, &v1alpha1.ExecuteCommandRequest{
+			Command: commands.ArchiveCompletedTasksCommand,
+			Path:    "sprint.md",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"sprint.md"}, resp.ChangedPaths)
+
+		updated, err := os.ReadFile(docPath)
+		require.NoError(t, err)
+		assert.Equal(t, `# Sprint
+
+- [ ] Write docs
+- [wip] Review PRs
+
+## Archive
+
+- [x] Ship parser
+  - [x] Add tests
+`, string(updated))
+	})
+
+	t.Run("unchanged document reports no paths", func(t *testing.T) {
+		resp, err := server.ExecuteCommand(ctx, &v1alpha1.ExecuteCommandRequest{
+			Command: commands.ArchiveCompletedTasksCommand,
+			Path:    "sprint.md",
+		})
+		require.NoError(t, err)
+		assert.Empty(t, resp.ChangedPaths)
+	})
+
+	t.Run("unknown command", func(t *testing.T) {
+		_, err := server.ExecuteCommand(ctx, &v1alpha1.ExecuteCommandRequest{
+			Command: "notedown.unknown",
+			Path:    "sprint.md",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("path outside workspace", func(t *testing.T) {
+		_, err := server.ExecuteCommand(ctx, &v1alpha1.ExecuteCommandRequest{
+			Command: commands.ArchiveCompletedTasksCommand,
+			Path:    "../sprint.md",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("missing document", func(t *testing.T) {
+		_, err := server.ExecuteCommand(ctx, &v1alpha1.ExecuteCommandRequest{
+			Command: commands.ArchiveCompletedTasksCommand,
+			Path:    "missing.md",
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestNewDocumentServer_WorkspaceOnlyConfig(t *testing.T) {
+	workspace := t.TempDir()
+	writeWorkspaceFiles(t, workspace, map[string]string{
+		".notedown/settings.yaml": "workspace:\n  ignore:\n    - \"archive/\"\n",
+		"note.md":                 "- [x] Done\n",
+		"archive/old.md":          "# Old\n",
+	})
+
+	server, err := NewDocumentServer(workspace)
+	require.NoError(t, err)
+	assert.Equal(t, config.GetDefaultConfig().Tasks, server.config.Tasks)
+
+	resp, err := server.ListDocuments(context.Background(), &v1alpha1.ListDocumentsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Documents, 1)
+	assert.Equal(t, "note.md", resp.Documents[0].Path)
+	require.Len(t, resp.Documents[0].Tasks, 1)
+}
+
+func TestDocumentServer_ExecuteCommandNormalizeFrontmatter(t *testing.T) {
+	workspace := t.TempDir()
+	writeWorkspaceFiles(t, workspace, map[string]string{
+		".notedown/settings.yaml": "frontmatter:\n  fields:\n    - name: title\n      required: true\n    - name: status\n      default: draft\n",
+		"note.md":                 "---\nstatus: active\ntitle: Note\n---\nBody\n",
+	})
+
+	server, err := NewDocumentServer(workspace)
+	require.NoError(t, err)
+
+	resp, err := server.ExecuteCommand(context.Background(), &v1alpha1.ExecuteCommandRequest{
+		Command: commands.NormalizeFrontmatterCommand,
+		Path:    "note.md",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"note.md"}, resp.ChangedPaths)
+
+	updated, err := os.ReadFile(filepath.Join(workspace, "note.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "---\ntitle: Note\nstatus: active\n---\nBody\n", string(updated))
+}
+
+func TestDocumentServer_ExecuteCommandRestrictsPaths(t *testing.T) {
+	workspace := t.TempDir()
+	outside := t.TempDir()
+	content := "- [x] Done\n"
+
+	writeWorkspaceFiles(t, workspace, map[string]string{
+		".notedown/settings.yaml": "tasks:\n  states:\n    - value: \"x\"\n      name: done\n",
+		".gitignore":              "drafts/\n",
+		"notes.txt":               content,
+		"drafts/draft.md":         content,
+		"build/output.md":         content,
+		"notes/real.md":           content,
+	})
+	writeWorkspaceFiles(t, outside, map[string]string{"outside.md": content})
+
+	require.NoError(t, os.Symlink(filepath.Join(workspace, "notes", "real.md"), filepath.Join(workspace, "alias.md")))
+	require.NoError(t, os.Symlink(filepath.Join(workspace, ".notedown", "settings.yaml"), filepath.Join(workspace, "linked-settings.md")))
+	require.NoError(t, os.Symlink(filepath.Join(outside, "outside.md"), filepath.Join(workspace, "escape.md")))
+	require.NoError(t, os.Symlink(outside, filepath.Join(workspace, "linked")))
+
+	server, err := NewDocumentServer(workspace)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{name: "settings file", path: ".notedown/settings.yaml"},
+		{name: "non-note file", path: "notes.txt"},
+		{name: "gitignored note", path: "drafts/draft.md"},
+		{name: "excluded directory", path: "build/output.md"},
+		{name: "symlink to a non-note file", path: "linked-settings.md"},
+		{name: "symlink outside the workspace", path: "escape.md"},
+		{name: "symlinked directory outside the workspace", path: "linked/outside.md"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := server.ExecuteCommand(context.Background(), &v1alpha1.ExecuteCommandRequest{
+				Command: commands.ArchiveCompletedTasksCommand,
+				Path:    tt.path,
+			})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+
+	t.Run("symlink to a note inside the workspace", func(t *testing.T) {
+		resp, err := server.ExecuteCommand(context.Background(), &v1alpha1.ExecuteCommandRequest{
+			Command: commands.ArchiveCompletedTasksCommand,
+			Path:    "alias.md",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alias.md"}, resp.ChangedPaths)
+
+		updated, err := os.ReadFile(filepath.Join(workspace, "notes", "real.md"))
+		require.NoError(t, err)
+		assert.Equal(t, "## Archive\n\n- [x] Done\n", string(updated))
+	})
+
+	outsideContent, err := os.ReadFile(filepath.Join(outside, "outside.md"))
+	require.NoError(t, err)
+	assert.Equal(t, content, string(outsideContent), "files outside the workspace are never rewritten")
+}
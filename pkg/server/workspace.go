@@ -19,15 +19,21 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
+
+	"github.com/notedownorg/notedown/pkg/config"
 )
 
 // workspaceDiscoverer handles workspace root discovery and file scanning
 type workspaceDiscoverer struct {
 	workspaceRoot   string
 	excludePatterns []string
+	ignorePatterns  []string // Gitignore-style patterns from the workspace configuration
+	extensions      []string // Lowercased note file extensions, including the leading dot
 	mu              sync.RWMutex
 }
 
@@ -38,10 +44,13 @@ type DocumentFile struct {
 	Checksum string // SHA-256 hash of content
 }
 
-// newWorkspaceDiscoverer creates a new workspace discoverer
-func newWorkspaceDiscoverer(workspaceRoot string) *workspaceDiscoverer {
+// newWorkspaceDiscoverer creates a new workspace discoverer for the configured note extensions that
+// also skips paths matched by the configured ignore patterns and by .gitignore files within the workspace
+func newWorkspaceDiscoverer(workspaceRoot string, cfg config.WorkspaceConfig) *workspaceDiscoverer {
 	return &workspaceDiscoverer{
-		workspaceRoot: workspaceRoot,
+		workspaceRoot:  workspaceRoot,
+		ignorePatterns: cfg.Ignore,
+		extensions:     cfg.MarkdownExtensions(),
 		excludePatterns: []string{
 			".git",
 			".vscode",
@@ -70,6 +79,7 @@ func (wd *workspaceDiscoverer) discoverDocuments() (<-chan *DocumentFile, <-chan
 	workspaceRoot := wd.workspaceRoot
 	excludePatterns := make([]string, len(wd.excludePatterns))
 	copy(excludePatterns, wd.excludePatterns)
+	ignore := newIgnoreMatcher(workspaceRoot, wd.ignorePatterns)
 	wd.mu.RUnlock()
 
 	docChan := make(chan *DocumentFile)
@@ -84,26 +94,31 @@ func (wd *workspaceDiscoverer) discoverDocuments() (<-chan *DocumentFile, <-chan
 				return nil // Continue walking despite errors
 			}
 
+			// The workspace root itself is never excluded
+			if path == workspaceRoot {
+				return nil
+			}
+
+			// Create relative path from workspace root
+			relPath, err := filepath.Rel(workspaceRoot, path)
+			if err != nil {
+				relPath = path // Fallback to absolute path
+			}
+
 			// Skip directories
 			if d.IsDir() {
 				// Check if this directory should be excluded
-				if wd.isExcludedPath(path, excludePatterns) {
+				if wd.isExcludedPath(relPath, excludePatterns) || ignore.isIgnored(filepath.ToSlash(relPath), true) {
 					return filepath.SkipDir
 				}
 				return nil
 			}
 
 			// Check if this is a Markdown file
-			if !wd.isMarkdownFile(path) {
+			if !wd.isMarkdownFile(path) || ignore.isIgnored(filepath.ToSlash(relPath), false) {
 				return nil
 			}
 
-			// Create relative path from workspace root
-			relPath, err := filepath.Rel(workspaceRoot, path)
-			if err != nil {
-				relPath = path // Fallback to absolute path
-			}
-
 			// Calculate checksum
 			checksum, err := wd.calculateChecksum(path)
 			if err != nil {
@@ -128,7 +143,7 @@ func (wd *workspaceDiscoverer) discoverDocuments() (<-chan *DocumentFile, <-chan
 	return docChan, errChan
 }
 
-// isExcludedPath checks if a path should be excluded from indexing
+// isExcludedPath checks if a path relative to the workspace root should be excluded from indexing
 func (wd *workspaceDiscoverer) isExcludedPath(path string, excludePatterns []string) bool {
 	// Check against exclusion patterns
 	for _, pattern := range excludePatterns {
@@ -145,10 +160,35 @@ func (wd *workspaceDiscoverer) isExcludedPath(path string, excludePatterns []str
 	return false
 }
 
-// isMarkdownFile checks if a file is a Markdown file
+// isDiscoverable checks if a path relative to the workspace root is a note that discovery would
+// return, that is it has a note extension and neither it nor any parent directory is excluded or ignored
+func (wd *workspaceDiscoverer) isDiscoverable(relPath string) bool {
+	wd.mu.RLock()
+	excludePatterns := slices.Clone(wd.excludePatterns)
+	ignore := newIgnoreMatcher(wd.workspaceRoot, wd.ignorePatterns)
+	wd.mu.RUnlock()
+
+	if !wd.isMarkdownFile(relPath) {
+		return false
+	}
+
+	slashPath := filepath.ToSlash(relPath)
+	parts := strings.Split(slashPath, "/")
+	dir := ""
+	for _, part := range parts[:len(parts)-1] {
+		dir = path.Join(dir, part)
+		if wd.isExcludedPath(filepath.FromSlash(dir), excludePatterns) || ignore.isIgnored(dir, true) {
+			return false
+		}
+	}
+
+	return !ignore.isIgnored(slashPath, false)
+}
+
+// isMarkdownFile checks if a file has one of the configured note extensions
 func (wd *workspaceDiscoverer) isMarkdownFile(path string) bool {
 	ext := strings.ToLower(filepath.Ext(path))
-	return ext == ".md"
+	return slices.Contains(wd.extensions, ext)
 }
 
 // calculateChecksum calculates SHA-256 checksum of file content
@@ -0,0 +1,226 @@
+// Copyright 2025 Notedown Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/notedownorg/notedown/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeWorkspaceFiles creates files relative to the workspace root, including parent directories
+func writeWorkspaceFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for path, content := range files {
+		absPath := filepath.Join(root, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(absPath), 0750))
+		require.NoError(t, os.WriteFile(absPath, []byte(content), 0600))
+	}
+}
+
+// discoveredPaths collects the slash-separated relative paths found by a discoverer
+func discoveredPaths(t *testing.T, wd *workspaceDiscoverer) []string {
+	t.Helper()
+	docChan, errChan := wd.discoverDocuments()
+
+	var paths []string
+	for doc := range docChan {
+		paths = append(paths, filepath.ToSlash(doc.Path))
+	}
+	require.NoError(t, <-errChan)
+
+	sort.Strings(paths)
+	return paths
+}
+
+func TestWorkspaceDiscoverer_Ignore(t *testing.T) {
+	root := t.TempDir()
+	writeWorkspaceFiles(t, root, map[string]string{
+		".notedown/settings.yaml":     "tasks:\n  states:\n    - value: \" \"\n      name: todo\n",
+		".notedown/notes.md":          "# Internal",
+		".gitignore":                  "# generated output\nscratch/\n*.draft.md\n!keep.draft.md\n/top-only.md\n",
+		"index.md":                    "# Index",
+		"top-only.md":                 "# Ignored at the root only",
+		"idea.draft.md":               "# Draft",
+		"keep.draft.md":               "# Kept draft",
+		"scratch/notes.md":            "# Scratch",
+		"projects/plan.md":            "# Plan",
+		"projects/top-only.md":        "# Not anchored here",
+		"projects/.gitignore":         "private/\nsecret.md\n",
+		"projects/secret.md":          "# Secret",
+		"projects/private/todo.md":    "# Private",
+		"projects/archive/old.md":     "# Old",
+		"projects/archive/2024/q1.md": "# Q1",
+		"vendor/docs/readme.md":       "# Vendored",
+		"docs/guide.md":               "# Guide",
+		"docs/generated/api.md":       "# API",
+	})
+
+	t.Run("gitignore files", func(t *testing.T) {
+		paths := discoveredPaths(t, newWorkspaceDiscoverer(root, config.WorkspaceConfig{}))
+		assert.Equal(t, []string{
+			"docs/generated/api.md",
+			"docs/guide.md",
+			"index.md",
+			"keep.draft.md",
+			"projects/archive/2024/q1.md",
+			"projects/archive/old.md",
+			"projects/plan.md",
+			"projects/top-only.md",
+			"vendor/docs/readme.md",
+		}, paths)
+	})
+
+	t.Run("configured ignore patterns", func(t *testing.T) {
+		paths := discoveredPaths(t, newWorkspaceDiscoverer(root, config.WorkspaceConfig{Ignore: []string{
+			"vendor/",
+			"**/generated",
+			"projects/archive/**",
+		}}))
+		assert.Equal(t, []string{
+			"docs/guide.md",
+			"index.md",
+			"keep.draft.md",
+			"projects/plan.md",
+			"projects/top-only.md",
+		}, paths)
+	})
+
+	t.Run("configured negation re-includes paths", func(t *testing.T) {
+		paths := discoveredPaths(t, newWorkspaceDiscoverer(root, config.WorkspaceConfig{Ignore: []string{"docs/**", "!docs/guide.md"}}))
+		assert.Contains(t, paths, "docs/guide.md")
+		assert.NotContains(t, paths, "docs/generated/api.md")
+	})
+
+	t.Run("gitignore negation does not override configured patterns", func(t *testing.T) {
+		writeWorkspaceFiles(t, root, map[string]string{
+			"docs/.gitignore": "!generated/\n",
+		})
+		defer func() { require.NoError(t, os.Remove(filepath.Join(root, "docs", ".gitignore"))) }()
+
+		paths := discoveredPaths(t, newWorkspaceDiscoverer(root, config.WorkspaceConfig{Ignore: []string{"generated/"}}))
+		assert.NotContains(t, paths, "docs/generated/api.md")
+		assert.Contains(t, paths, "docs/guide.md")
+	})
+}
+
+func TestParseIgnoreRule(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		path     string
+		isDir    bool
+		expected bool
+	}{
+		{"*.md", "notes/today.md", false, true},
+		{"*.md", "notes", true, false},
+		{"build/", "build", true, true},
+		{"build/", "build", false, false},
+		{"/root.md", "root.md", false, true},
+		{"/root.md", "sub/root.md", false, false},
+		{"docs/*.md", "docs/a.md", false, true},
+		{"docs/*.md", "docs/sub/a.md", false, false},
+		{"**/drafts", "a/b/drafts", true, true},
+		{"**/drafts", "drafts", true, true},
+		{"a/**/z.md", "a/z.md", false, true},
+		{"a/**/z.md", "a/b/c/z.md", false, true},
+		{"note?.md", "note1.md", false, true},
+		{"note[!0-9].md", "note1.md", false, false},
+		{"note[!0-9].md", "noteA.md", false, true},
+		{`\#hash.md`, "#hash.md", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			rule, ok := parseIgnoreRule(tt.pattern, "")
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, rule.matches(tt.path, tt.isDir))
+		})
+	}
+
+	t.Run("comments and blank lines", func(t *testing.T) {
+		for _, line := range []string{"", "   ", "# comment", "!"} {
+			_, ok := parseIgnoreRule(line, "")
+			assert.False(t, ok, "line %q", line)
+		}
+	})
+
+	t.Run("negation and base directory", func(t *testing.T) {
+		rule, ok := parseIgnoreRule("!keep.md", "sub")
+		require.True(t, ok)
+		assert.True(t, rule.negate)
+		assert.True(t, rule.matches("sub/deep/keep.md", false))
+		assert.False(t, rule.matches("other/keep.md", false))
+	})
+}
+
+func TestWorkspaceDiscoverer_Extensions(t *testing.T) {
+	root := t.TempDir()
+	writeWorkspaceFiles(t, root, map[string]string{
+		"note.md":            "# Markdown",
+		"long.markdown":      "# Long extension",
+		"component.MDX":      "# MDX",
+		"plain.txt":          "Not a note",
+		"nested/deep.mdx":    "# Nested MDX",
+		"nested/readme.md":   "# Nested markdown",
+		"nested/image.png":   "binary",
+		"nested/archive.zip": "binary",
+	})
+
+	t.Run("defaults to markdown files", func(t *testing.T) {
+		paths := discoveredPaths(t, newWorkspaceDiscoverer(root, config.WorkspaceConfig{}))
+		assert.Equal(t, []string{"nested/readme.md", "note.md"}, paths)
+	})
+
+	t.Run("configured extensions", func(t *testing.T) {
+		paths := discoveredPaths(t, newWorkspaceDiscoverer(root, config.WorkspaceConfig{
+			Extensions: []string{".md", "markdown", ".mdx"},
+		}))
+		assert.Equal(t, []string{
+			"component.MDX",
+			"long.markdown",
+			"nested/deep.mdx",
+			"nested/readme.md",
+			"note.md",
+		}, paths)
+	})
+
+	t.Run("configured extensions replace the default", func(t *testing.T) {
+		paths := discoveredPaths(t, newWorkspaceDiscoverer(root, config.WorkspaceConfig{
+			Extensions: []string{".mdx"},
+		}))
+		assert.Equal(t, []string{"component.MDX", "nested/deep.mdx"}, paths)
+	})
+}
+
+func TestWorkspaceDiscoverer_ExcludesRelativeToRoot(t *testing.T) {
+	// Built-in excludes only apply to paths inside the workspace, not to the directories above it
+	root := filepath.Join(t.TempDir(), "build", "env", ".cache", "notes")
+	writeWorkspaceFiles(t, root, map[string]string{
+		"index.md":             "# Index",
+		"projects/plan.md":     "# Plan",
+		"build/output.md":      "# Build output",
+		"projects/env/vars.md": "# Environment",
+		".hidden/secret.md":    "# Hidden",
+		"node_modules/pkg.md":  "# Dependency",
+	})
+
+	paths := discoveredPaths(t, newWorkspaceDiscoverer(root, config.WorkspaceConfig{}))
+	assert.Equal(t, []string{"index.md", "projects/plan.md"}, paths)
+}